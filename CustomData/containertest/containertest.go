@@ -0,0 +1,420 @@
+// Package containertest implements behavioural test suites for the container
+// contracts of CustomData. Any implementation of listlike.Stacker,
+// listlike.Queuer or sets.Set, including those written outside of this module,
+// can be checked by calling the corresponding function from a test.
+package containertest
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/mysd-lib/CustomData/listlike"
+	"github.com/PlayerR9/mysd-lib/CustomData/sets"
+)
+
+// checkElems fails the test right away if there are not enough distinct elements.
+//
+// Parameters:
+//   - t: The test.
+//   - elems: The elements provided to the suite.
+func checkElems[T comparable](t *testing.T, elems []T) {
+	t.Helper()
+
+	if len(elems) < 3 {
+		t.Fatalf("want at least 3 elements, got %d", len(elems))
+	}
+
+	seen := make(map[T]struct{}, len(elems))
+
+	for _, elem := range elems {
+		if _, ok := seen[elem]; ok {
+			t.Fatalf("want distinct elements, got %v twice", elem)
+		}
+
+		seen[elem] = struct{}{}
+	}
+}
+
+// checkSize fails the test if the container does not have the given size.
+//
+// Parameters:
+//   - t: The test.
+//   - l: The container.
+//   - want: The expected size.
+func checkSize(t *testing.T, l interface {
+	Size() int
+	IsEmpty() bool
+}, want int) {
+	t.Helper()
+
+	if got := l.Size(); got != want {
+		t.Errorf("want size %d, got %d", want, got)
+	}
+
+	if got := l.IsEmpty(); got != (want == 0) {
+		t.Errorf("want IsEmpty %t, got %t", want == 0, got)
+	}
+}
+
+// TestStacker runs the behavioural suite of listlike.Stacker.
+//
+// Parameters:
+//   - t: The test.
+//   - newStack: The function that returns a new, empty stack. It must be able to
+//     hold at least len(elems) elements.
+//   - elems: At least 3 distinct elements to use in the suite.
+func TestStacker[T comparable](t *testing.T, newStack func() listlike.Stacker[T], elems []T) {
+	t.Helper()
+
+	checkElems(t, elems)
+
+	t.Run("empty", func(t *testing.T) {
+		s := newStack()
+		checkSize(t, s, 0)
+
+		_, err := s.Pop()
+		if !errors.Is(err, listlike.ErrEmptyStack) {
+			t.Errorf("want %v, got %v", listlike.ErrEmptyStack, err)
+		}
+
+		_, err = s.Peek()
+		if !errors.Is(err, listlike.ErrEmptyStack) {
+			t.Errorf("want %v, got %v", listlike.ErrEmptyStack, err)
+		}
+	})
+
+	t.Run("LIFO", func(t *testing.T) {
+		s := newStack()
+
+		for i, elem := range elems {
+			err := s.Push(elem)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+
+			checkSize(t, s, i+1)
+
+			top, err := s.Peek()
+			if err != nil || top != elem {
+				t.Errorf("want %v, got %v (%v)", elem, top, err)
+			}
+		}
+
+		for i := len(elems) - 1; i >= 0; i-- {
+			elem, err := s.Pop()
+			if err != nil || elem != elems[i] {
+				t.Errorf("want %v, got %v (%v)", elems[i], elem, err)
+			}
+		}
+
+		checkSize(t, s, 0)
+	})
+
+	t.Run("PushMany", func(t *testing.T) {
+		s := newStack()
+
+		err := s.PushMany(slices.Clone(elems))
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		checkSize(t, s, len(elems))
+
+		for _, want := range elems {
+			elem, err := s.Pop()
+			if err != nil || elem != want {
+				t.Errorf("want %v, got %v (%v)", want, elem, err)
+			}
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		s := newStack()
+
+		_ = s.PushMany(slices.Clone(elems))
+		s.Reset()
+
+		checkSize(t, s, 0)
+
+		err := s.Push(elems[0])
+		if err != nil {
+			t.Errorf("want no error, got %v", err)
+		}
+
+		checkSize(t, s, 1)
+	})
+}
+
+// TestQueuer runs the behavioural suite of listlike.Queuer.
+//
+// Parameters:
+//   - t: The test.
+//   - newQueue: The function that returns a new, empty queue. It must be able to
+//     hold at least len(elems) elements.
+//   - elems: At least 3 distinct elements to use in the suite.
+func TestQueuer[T comparable](t *testing.T, newQueue func() listlike.Queuer[T], elems []T) {
+	t.Helper()
+
+	checkElems(t, elems)
+
+	t.Run("empty", func(t *testing.T) {
+		q := newQueue()
+		checkSize(t, q, 0)
+
+		_, err := q.Dequeue()
+		if !errors.Is(err, listlike.ErrEmptyQueue) {
+			t.Errorf("want %v, got %v", listlike.ErrEmptyQueue, err)
+		}
+
+		_, err = q.First()
+		if !errors.Is(err, listlike.ErrEmptyQueue) {
+			t.Errorf("want %v, got %v", listlike.ErrEmptyQueue, err)
+		}
+	})
+
+	t.Run("FIFO", func(t *testing.T) {
+		q := newQueue()
+
+		for i, elem := range elems {
+			err := q.Enqueue(elem)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+
+			checkSize(t, q, i+1)
+
+			first, err := q.First()
+			if err != nil || first != elems[0] {
+				t.Errorf("want %v, got %v (%v)", elems[0], first, err)
+			}
+		}
+
+		for _, want := range elems {
+			elem, err := q.Dequeue()
+			if err != nil || elem != want {
+				t.Errorf("want %v, got %v (%v)", want, elem, err)
+			}
+		}
+
+		checkSize(t, q, 0)
+	})
+
+	t.Run("EnqueueMany", func(t *testing.T) {
+		q := newQueue()
+
+		err := q.EnqueueMany(slices.Clone(elems))
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		checkSize(t, q, len(elems))
+
+		for _, want := range elems {
+			elem, err := q.Dequeue()
+			if err != nil || elem != want {
+				t.Errorf("want %v, got %v (%v)", want, elem, err)
+			}
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		q := newQueue()
+
+		_ = q.EnqueueMany(slices.Clone(elems))
+		q.Reset()
+
+		checkSize(t, q, 0)
+
+		err := q.Enqueue(elems[0])
+		if err != nil {
+			t.Errorf("want no error, got %v", err)
+		}
+
+		checkSize(t, q, 1)
+	})
+}
+
+// TestSet runs the behavioural suite of sets.Set.
+//
+// Parameters:
+//   - t: The test.
+//   - newSet: The function that returns a new, empty set.
+//   - elems: At least 3 distinct elements to use in the suite.
+func TestSet[T comparable](t *testing.T, newSet func() sets.Set[T], elems []T) {
+	t.Helper()
+
+	checkElems(t, elems)
+
+	t.Run("empty", func(t *testing.T) {
+		s := newSet()
+		checkSize(t, s, 0)
+
+		if s.Contains(elems[0]) {
+			t.Errorf("want %v to be absent", elems[0])
+		}
+
+		for elem := range s.Elem() {
+			t.Errorf("want no element, got %v", elem)
+		}
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		s := newSet()
+
+		for i, elem := range elems {
+			err := s.Add(elem)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+
+			_ = s.Add(elem)
+
+			checkSize(t, s, i+1)
+
+			if !s.Contains(elem) {
+				t.Errorf("want %v to be present", elem)
+			}
+		}
+	})
+
+	t.Run("AddMany", func(t *testing.T) {
+		s := newSet()
+
+		err := s.AddMany(append(slices.Clone(elems), elems...))
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		checkSize(t, s, len(elems))
+
+		seen := make(map[T]int, len(elems))
+
+		for elem := range s.Elem() {
+			seen[elem]++
+		}
+
+		for _, elem := range elems {
+			if seen[elem] != 1 {
+				t.Errorf("want %v to be iterated once, got %d", elem, seen[elem])
+			}
+		}
+
+		if len(seen) != len(elems) {
+			t.Errorf("want %d iterated elements, got %d", len(elems), len(seen))
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		s := newSet()
+
+		_ = s.AddMany(slices.Clone(elems))
+		s.Reset()
+
+		checkSize(t, s, 0)
+
+		if s.Contains(elems[0]) {
+			t.Errorf("want %v to be absent", elems[0])
+		}
+
+		err := s.Add(elems[0])
+		if err != nil {
+			t.Errorf("want no error, got %v", err)
+		}
+
+		checkSize(t, s, 1)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		s := newSet()
+		_ = s.AddMany(slices.Clone(elems))
+
+		if !s.Remove(elems[0]) || s.Remove(elems[0]) {
+			t.Errorf("want %v to be removed exactly once", elems[0])
+		}
+
+		checkSize(t, s, len(elems)-1)
+
+		if s.Contains(elems[0]) {
+			t.Errorf("want %v to be absent", elems[0])
+		}
+
+		got := s.RemoveMany([]T{elems[0], elems[1], elems[1]})
+		if got != 1 {
+			t.Errorf("want 1 removed element, got %d", got)
+		}
+
+		checkSize(t, s, len(elems)-2)
+	})
+
+	t.Run("Pop", func(t *testing.T) {
+		s := newSet()
+		_ = s.AddMany(slices.Clone(elems))
+
+		seen := make(map[T]struct{}, len(elems))
+
+		for range elems {
+			elem, err := s.Pop()
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+
+			if _, ok := seen[elem]; ok || s.Contains(elem) {
+				t.Errorf("want %v to be popped once", elem)
+			}
+
+			seen[elem] = struct{}{}
+		}
+
+		checkSize(t, s, 0)
+
+		_, err := s.Pop()
+		if !errors.Is(err, sets.ErrEmptySet) {
+			t.Errorf("want %v, got %v", sets.ErrEmptySet, err)
+		}
+	})
+
+	t.Run("RemoveIf", func(t *testing.T) {
+		s := newSet()
+		_ = s.AddMany(slices.Clone(elems))
+
+		got := s.RemoveIf(func(elem T) bool {
+			return elem != elems[0]
+		})
+		if got != len(elems)-1 {
+			t.Errorf("want %d removed elements, got %d", len(elems)-1, got)
+		}
+
+		checkSize(t, s, 1)
+
+		if !s.Contains(elems[0]) {
+			t.Errorf("want %v to be present", elems[0])
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		s := newSet()
+		_ = s.AddMany(slices.Clone(elems))
+
+		c := s.Clone()
+		checkSize(t, c, len(elems))
+
+		c.Remove(elems[0])
+		_ = s.Remove(elems[1])
+
+		if !s.Contains(elems[0]) || !c.Contains(elems[1]) {
+			t.Errorf("want the clone to be independent of the original")
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		s := newSet()
+		_ = s.AddMany(slices.Clone(elems))
+
+		s.Clear()
+		checkSize(t, s, 0)
+
+		_ = s.AddMany(slices.Clone(elems))
+		checkSize(t, s, len(elems))
+	})
+}
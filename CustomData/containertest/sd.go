@@ -0,0 +1,11 @@
+package containertest
+
+// containertestT is for private use only.
+type containertestT struct{}
+
+// SD is the namespace for SD-like functions.
+var SD containertestT
+
+func init() {
+	SD = containertestT{}
+}
@@ -0,0 +1,563 @@
+package listlike
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+)
+
+// State is the state of an automaton.
+type State int
+
+const (
+	// StateReady is the state of an automaton that was not stepped since it was
+	// created or reset.
+	StateReady State = iota
+
+	// StateRunning is the state of an automaton that produced an output and can
+	// produce more.
+	StateRunning
+
+	// StateDone is the terminal state of an automaton that produced its last
+	// output.
+	StateDone
+
+	// StateFailed is the terminal state of an automaton whose last step failed.
+	StateFailed
+)
+
+// IsTerminal checks whether the state is terminal; that is, whether the
+// automaton must be reset before it can be stepped again.
+//
+// Returns:
+//   - bool: True if the state is terminal, false otherwise.
+func (s State) IsTerminal() bool {
+	return s == StateDone || s == StateFailed
+}
+
+// Aut is a step automaton: every step consumes an input and produces an output,
+// until the automaton reaches a terminal state.
+//
+// An implementation must follow these rules:
+//   - Step leaves the automaton in any state but StateReady.
+//   - Step returns a nil error if and only if the automaton does not end up in
+//     StateFailed.
+//   - Step on an automaton in a terminal state returns ErrTerminal and leaves
+//     the state unchanged.
+//   - Reset puts the automaton back in StateReady.
+//
+// Composed automata own their parts: the same instance must not appear twice
+// in a composition.
+type Aut[I, O any] interface {
+	// Step advances the automaton by one step.
+	//
+	// Parameters:
+	//   - input: The input of the step.
+	//
+	// Returns:
+	//   - O: The output of the step. Only meaningful when the error is nil.
+	//   - error: An error if the step failed.
+	//
+	// Errors:
+	//   - ErrTerminal: If the automaton is in a terminal state.
+	//   - any other error: Depending on the implementation.
+	Step(input I) (O, error)
+
+	// State returns the current state of the automaton.
+	//
+	// Returns:
+	//   - State: The current state.
+	State() State
+
+	// Reset puts the automaton back in StateReady.
+	Reset()
+}
+
+// Run resets the automaton and steps it with the same input until it reaches a
+// terminal state, yielding the output of every step. If a step fails, its error
+// is yielded with the zero value and the iteration stops. Run never panics: a
+// panic of the automaton is yielded as an ErrPanicked error.
+//
+// Parameters:
+//   - aut: The automaton to run.
+//   - input: The input of every step.
+//
+// Returns:
+//   - iter.Seq2[O, error]: The outputs of the automaton. Never returns nil.
+//
+// Errors:
+//   - ErrNoAutomaton: If aut is nil.
+//   - ErrBadlyImplemented: If aut does not respect the Aut contract.
+//   - ErrPanicked: If aut panicked.
+//   - any error returned by aut.Step.
+func Run[I, O any](aut Aut[I, O], input I) iter.Seq2[O, error] {
+	return func(yield func(O, error) bool) {
+		if aut == nil {
+			yield(*new(O), ErrNoAutomaton)
+			return
+		}
+
+		err := safeReset(aut)
+		if err != nil {
+			yield(*new(O), err)
+			return
+		}
+
+		defer safeReset(aut)
+
+		for {
+			out, err := safeStep(aut, input)
+			if errors.Is(err, ErrPanicked) {
+				yield(*new(O), err)
+				return
+			}
+
+			state, panicked := safeState(aut)
+			if panicked != nil {
+				yield(*new(O), panicked)
+				return
+			}
+
+			if state == StateReady || (err == nil) == (state == StateFailed) {
+				yield(*new(O), ErrBadlyImplemented)
+				return
+			}
+
+			if err != nil {
+				yield(*new(O), err)
+				return
+			}
+
+			if !yield(out, nil) || state.IsTerminal() {
+				return
+			}
+		}
+	}
+}
+
+// safeReset resets the automaton and turns a panic into an error.
+//
+// Returns:
+//   - error: An error wrapping ErrPanicked if Reset panicked.
+func safeReset[I, O any](aut Aut[I, O]) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrPanicked, r)
+		}
+	}()
+
+	aut.Reset()
+
+	return nil
+}
+
+// safeStep steps the automaton and turns a panic into an error.
+//
+// Returns:
+//   - O: The output of the step.
+//   - error: The error of the step, or an error wrapping ErrPanicked if Step
+//     panicked.
+func safeStep[I, O any](aut Aut[I, O], input I) (out O, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			out, err = *new(O), fmt.Errorf("%w: %v", ErrPanicked, r)
+		}
+	}()
+
+	return aut.Step(input)
+}
+
+// safeState returns the state of the automaton and turns a panic into an error.
+//
+// Returns:
+//   - State: The current state of the automaton.
+//   - error: An error wrapping ErrPanicked if State panicked.
+func safeState[I, O any](aut Aut[I, O]) (state State, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrPanicked, r)
+		}
+	}()
+
+	return aut.State(), nil
+}
+
+// Call runs the automaton with the given input until it reaches a terminal
+// state and returns its last output.
+//
+// Parameters:
+//   - aut: The automaton to run.
+//   - input: The input of every step.
+//
+// Returns:
+//   - O: The last output of the automaton.
+//   - error: An error if a step failed.
+//
+// Errors:
+//   - ErrNoAutomaton: If aut is nil.
+//   - ErrBadlyImplemented: If aut does not respect the Aut contract.
+//   - ErrPanicked: If aut panicked.
+//   - any error returned by aut.Step.
+func Call[I, O any](aut Aut[I, O], input I) (O, error) {
+	var last O
+
+	for out, err := range Run(aut, input) {
+		if err != nil {
+			return *new(O), err
+		}
+
+		last = out
+	}
+
+	return last, nil
+}
+
+// funcAut is an automaton that produces a single output.
+type funcAut[I, O any] struct {
+	// fn computes the output.
+	fn func(input I) (O, error)
+
+	// state is the current state.
+	state State
+}
+
+// Step implements the Aut interface.
+func (a *funcAut[I, O]) Step(input I) (O, error) {
+	if a.state.IsTerminal() {
+		return *new(O), ErrTerminal
+	}
+
+	out, err := a.fn(input)
+	if err != nil {
+		a.state = StateFailed
+		return *new(O), err
+	}
+
+	a.state = StateDone
+
+	return out, nil
+}
+
+// State implements the Aut interface.
+func (a funcAut[I, O]) State() State {
+	return a.state
+}
+
+// Reset implements the Aut interface.
+func (a *funcAut[I, O]) Reset() {
+	a.state = StateReady
+}
+
+// Func creates an automaton that produces a single output computed by the
+// given function.
+//
+// Parameters:
+//   - fn: The function that computes the output.
+//
+// Returns:
+//   - Aut[I, O]: The new automaton. Nil if fn is nil.
+func Func[I, O any](fn func(input I) (O, error)) Aut[I, O] {
+	if fn == nil {
+		return nil
+	}
+
+	return &funcAut[I, O]{
+		fn: fn,
+	}
+}
+
+// compact returns the non-nil automata of the given slice.
+func compact[I, O any](auts []Aut[I, O]) []Aut[I, O] {
+	var result []Aut[I, O]
+
+	for _, aut := range auts {
+		if aut != nil {
+			result = append(result, aut)
+		}
+	}
+
+	return result
+}
+
+// seqAut runs automata one after the other.
+type seqAut[I, O any] struct {
+	// auts are the automata to run.
+	auts []Aut[I, O]
+
+	// idx is the index of the running automaton.
+	idx int
+
+	// state is the current state.
+	state State
+}
+
+// Step implements the Aut interface.
+func (a *seqAut[I, O]) Step(input I) (O, error) {
+	if a.state.IsTerminal() {
+		return *new(O), ErrTerminal
+	}
+
+	if a.idx == len(a.auts) {
+		a.state = StateDone
+		return *new(O), nil
+	}
+
+	out, err := a.auts[a.idx].Step(input)
+	if err != nil {
+		a.state = StateFailed
+		return *new(O), err
+	}
+
+	a.state = StateRunning
+
+	if a.auts[a.idx].State().IsTerminal() {
+		a.idx++
+
+		if a.idx == len(a.auts) {
+			a.state = StateDone
+		}
+	}
+
+	return out, nil
+}
+
+// State implements the Aut interface.
+func (a seqAut[I, O]) State() State {
+	return a.state
+}
+
+// Reset implements the Aut interface.
+func (a *seqAut[I, O]) Reset() {
+	for _, aut := range a.auts {
+		aut.Reset()
+	}
+
+	a.idx = 0
+	a.state = StateReady
+}
+
+// Sequence creates an automaton that runs the given automata one after the
+// other, producing all of their outputs. It fails as soon as one of them fails.
+// Nil automata are ignored; with no automaton, it produces a single zero output.
+//
+// Parameters:
+//   - auts: The automata to run.
+//
+// Returns:
+//   - Aut[I, O]: The new automaton. Never returns nil.
+func Sequence[I, O any](auts ...Aut[I, O]) Aut[I, O] {
+	return &seqAut[I, O]{
+		auts: compact(auts),
+	}
+}
+
+// choiceAut runs the first automaton whose first step succeeds.
+type choiceAut[I, O any] struct {
+	// auts are the alternatives.
+	auts []Aut[I, O]
+
+	// chosen is the index of the chosen alternative. -1 if none was chosen yet.
+	chosen int
+
+	// state is the current state.
+	state State
+}
+
+// Step implements the Aut interface.
+func (a *choiceAut[I, O]) Step(input I) (O, error) {
+	if a.state.IsTerminal() {
+		return *new(O), ErrTerminal
+	}
+
+	if a.chosen == -1 {
+		if len(a.auts) == 0 {
+			a.state = StateFailed
+			return *new(O), ErrNoAutomaton
+		}
+
+		errs := make([]error, 0, len(a.auts))
+
+		for i, aut := range a.auts {
+			aut.Reset()
+
+			out, err := aut.Step(input)
+			if err == nil {
+				a.chosen = i
+				a.state = max(aut.State(), StateRunning)
+
+				return out, nil
+			}
+
+			errs = append(errs, err)
+		}
+
+		a.state = StateFailed
+
+		return *new(O), errors.Join(errs...)
+	}
+
+	aut := a.auts[a.chosen]
+
+	out, err := aut.Step(input)
+	if err != nil {
+		a.state = StateFailed
+		return *new(O), err
+	}
+
+	a.state = max(aut.State(), StateRunning)
+
+	return out, nil
+}
+
+// State implements the Aut interface.
+func (a choiceAut[I, O]) State() State {
+	return a.state
+}
+
+// Reset implements the Aut interface.
+func (a *choiceAut[I, O]) Reset() {
+	for _, aut := range a.auts {
+		aut.Reset()
+	}
+
+	a.chosen = -1
+	a.state = StateReady
+}
+
+// Choice creates an automaton that commits to the first of the given automata
+// whose first step succeeds, in order, and then behaves like it. If every first
+// step fails, it fails with all of their errors joined. Nil automata are ignored.
+//
+// Parameters:
+//   - auts: The alternatives.
+//
+// Returns:
+//   - Aut[I, O]: The new automaton. Never returns nil.
+func Choice[I, O any](auts ...Aut[I, O]) Aut[I, O] {
+	return &choiceAut[I, O]{
+		auts:   compact(auts),
+		chosen: -1,
+	}
+}
+
+// repeatAut runs an automaton several times.
+type repeatAut[I, O any] struct {
+	// aut is the automaton to repeat.
+	aut Aut[I, O]
+
+	// times is the number of runs. Not positive means unlimited.
+	times int
+
+	// count is the number of completed runs.
+	count int
+
+	// state is the current state.
+	state State
+}
+
+// Step implements the Aut interface.
+func (a *repeatAut[I, O]) Step(input I) (O, error) {
+	if a.state.IsTerminal() {
+		return *new(O), ErrTerminal
+	}
+
+	out, err := a.aut.Step(input)
+	if err != nil {
+		a.state = StateFailed
+		return *new(O), err
+	}
+
+	a.state = StateRunning
+
+	if a.aut.State().IsTerminal() {
+		a.count++
+
+		if a.times > 0 && a.count >= a.times {
+			a.state = StateDone
+		} else {
+			a.aut.Reset()
+		}
+	}
+
+	return out, nil
+}
+
+// State implements the Aut interface.
+func (a repeatAut[I, O]) State() State {
+	return a.state
+}
+
+// Reset implements the Aut interface.
+func (a *repeatAut[I, O]) Reset() {
+	a.aut.Reset()
+	a.count = 0
+	a.state = StateReady
+}
+
+// Repeat creates an automaton that runs the given automaton to completion the
+// given number of times, resetting it in between, and produces all of its
+// outputs. It fails as soon as a run fails.
+//
+// Parameters:
+//   - aut: The automaton to repeat.
+//   - times: The number of runs. If it is not positive, the automaton is
+//     repeated until it fails.
+//
+// Returns:
+//   - Aut[I, O]: The new automaton. Nil if aut is nil.
+func Repeat[I, O any](aut Aut[I, O], times int) Aut[I, O] {
+	if aut == nil {
+		return nil
+	}
+
+	return &repeatAut[I, O]{
+		aut:   aut,
+		times: times,
+	}
+}
+
+// isNil checks whether v is nil, including a nil pointer, map, slice, channel or
+// function stored in an interface.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// Size creates an automaton that produces the size of its input. A nil input,
+// including a typed nil pointer, has a size of 0.
+//
+// Returns:
+//   - Aut[L, int]: The new automaton. Never returns nil.
+func Size[L interface{ Size() int }]() Aut[L, int] {
+	return Func(func(l L) (int, error) {
+		if isNil(l) {
+			return 0, nil
+		}
+
+		return l.Size(), nil
+	})
+}
+
+// IsEmpty creates an automaton that produces whether its input is empty. A nil
+// input, including a typed nil pointer, is empty.
+//
+// Returns:
+//   - Aut[L, bool]: The new automaton. Never returns nil.
+func IsEmpty[L interface{ IsEmpty() bool }]() Aut[L, bool] {
+	return Func(func(l L) (bool, error) {
+		if isNil(l) {
+			return true, nil
+		}
+
+		return l.IsEmpty(), nil
+	})
+}
@@ -0,0 +1,105 @@
+package listlike
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// panickyAut is an automaton whose State method panics.
+type panickyAut struct{}
+
+// Step implements the Aut interface.
+func (panickyAut) Step(input int) (int, error) {
+	return input, nil
+}
+
+// State implements the Aut interface.
+func (panickyAut) State() State {
+	panic("boom")
+}
+
+// Reset implements the Aut interface.
+func (panickyAut) Reset() {}
+
+// TestAutomaton tests the built-in automata and their composition.
+func TestAutomaton(t *testing.T) {
+	q := NewQueue([]int{1, 2, 3})
+
+	size, err := Call(Size[Lister](), Lister(q))
+	if err != nil || size != 3 {
+		t.Errorf("want 3, got %d (%v)", size, err)
+	}
+
+	empty, _ := Call(IsEmpty[Lister](), nil)
+	if !empty {
+		t.Errorf("want true, got false")
+	}
+
+	var nil_stack *ArrayStack[int]
+
+	size, err = Call(Size[*ArrayStack[int]](), nil_stack)
+	if err != nil || size != 0 {
+		t.Errorf("want 0, got %d (%v)", size, err)
+	}
+
+	empty, err = Call(IsEmpty[*ArrayStack[int]](), nil_stack)
+	if err != nil || !empty {
+		t.Errorf("want true, got %t (%v)", empty, err)
+	}
+
+	_, err = Call(Func(func(n int) (int, error) {
+		panic("boom")
+	}), 0)
+	if !errors.Is(err, ErrPanicked) {
+		t.Errorf("want %v, got %v", ErrPanicked, err)
+	}
+
+	_, err = Call[int, int](panickyAut{}, 0)
+	if !errors.Is(err, ErrPanicked) {
+		t.Errorf("want %v, got %v", ErrPanicked, err)
+	}
+
+	errOdd := errors.New("odd")
+
+	even := Func(func(n int) (string, error) {
+		if n%2 != 0 {
+			return "", errOdd
+		}
+
+		return "even", nil
+	})
+
+	anything := func() Aut[int, string] {
+		return Func(func(n int) (string, error) {
+			return "any", nil
+		})
+	}
+
+	aut := Sequence(Repeat(Choice(even, anything()), 2), anything())
+
+	var got []string
+
+	for out, err := range Run(aut, 3) {
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		got = append(got, out)
+	}
+
+	want := []string{"any", "any", "any"}
+	if !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	_, err = Call(Sequence(anything(), even), 3)
+	if err != errOdd {
+		t.Errorf("want %v, got %v", errOdd, err)
+	}
+
+	_, err = Call[int, string](nil, 0)
+	if err != ErrNoAutomaton {
+		t.Errorf("want %v, got %v", ErrNoAutomaton, err)
+	}
+}
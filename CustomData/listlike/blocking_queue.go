@@ -0,0 +1,335 @@
+package listlike
+
+import (
+	"context"
+	"sync"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// BlockingQueue is a queue that is safe for concurrent use. Enqueue blocks
+// while the queue is full and Dequeue blocks while the queue is empty. An
+// empty, unbounded queue can either be created with the `var queue BlockingQueue[T]`
+// syntax or with the `new(BlockingQueue[T])` constructor.
+//
+// A BlockingQueue must not be copied after first use.
+type BlockingQueue[T any] struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+
+	// queue is the underlying queue.
+	queue Queue[T]
+
+	// capacity is the maximum number of elements. 0 means unbounded.
+	capacity int
+
+	// closed is true if the queue was closed.
+	closed bool
+
+	// notEmpty is closed and discarded whenever an element is added or the
+	// queue is closed.
+	notEmpty chan struct{}
+
+	// notFull is closed and discarded whenever an element is removed or the
+	// queue is closed.
+	notFull chan struct{}
+}
+
+// Size implements the Lister interface.
+func (q *BlockingQueue[T]) Size() int {
+	if q == nil {
+		return 0
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+// IsEmpty implements the Lister interface.
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Reset implements the Lister interface.
+//
+// Reset discards every element and reopens the queue. Goroutines blocked on
+// Enqueue are woken up.
+func (q *BlockingQueue[T]) Reset() {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Reset()
+	q.closed = false
+
+	broadcast(&q.notFull)
+}
+
+// NewBlockingQueue creates a new blocking queue.
+//
+// Parameters:
+//   - capacity: The maximum number of elements in the queue. 0 means unbounded.
+//
+// Returns:
+//   - *BlockingQueue[T]: The new queue. Nil if an error occurred.
+//   - error: An error if the queue could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If capacity is negative.
+func NewBlockingQueue[T any](capacity int) (*BlockingQueue[T], error) {
+	if capacity < 0 {
+		return nil, common.NewErrBadParam("capacity", "must be non-negative")
+	}
+
+	q := &BlockingQueue[T]{
+		capacity: capacity,
+	}
+
+	if capacity > 0 {
+		_ = q.queue.Reserve(capacity)
+	}
+
+	return q, nil
+}
+
+// broadcast wakes up every goroutine waiting on the given channel.
+//
+// Parameters:
+//   - ch: The channel to broadcast on. Assumed to be non-nil.
+func broadcast(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
+
+// waiter returns the channel to wait on, creating it if needed.
+//
+// Parameters:
+//   - ch: The channel to wait on. Assumed to be non-nil.
+//
+// Returns:
+//   - chan struct{}: The channel to wait on. Never returns nil.
+func waiter(ch *chan struct{}) chan struct{} {
+	if *ch == nil {
+		*ch = make(chan struct{})
+	}
+
+	return *ch
+}
+
+// isFull checks whether the queue has reached its capacity. Must be called
+// with the lock held.
+func (q *BlockingQueue[T]) isFull() bool {
+	return q.capacity > 0 && q.queue.Size() >= q.capacity
+}
+
+// TryEnqueue adds an element to the queue without blocking.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the element could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrClosedQueue: If the queue was closed.
+//   - ErrFullQueue: If the queue is full.
+func (q *BlockingQueue[T]) TryEnqueue(elem T) error {
+	if q == nil {
+		return common.ErrNilReceiver
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosedQueue
+	} else if q.isFull() {
+		return ErrFullQueue
+	}
+
+	_ = q.queue.Enqueue(elem)
+	broadcast(&q.notEmpty)
+
+	return nil
+}
+
+// Enqueue adds an element to the queue, blocking until there is space for it,
+// the queue is closed, or the context is done.
+//
+// Parameters:
+//   - ctx: The context of the operation.
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the element could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If ctx is nil.
+//   - ErrClosedQueue: If the queue was closed.
+//   - any error returned by ctx.Err(): If the context is done before there is space.
+func (q *BlockingQueue[T]) Enqueue(ctx context.Context, elem T) error {
+	if q == nil {
+		return common.ErrNilReceiver
+	} else if ctx == nil {
+		return common.NewErrNilParam("ctx")
+	}
+
+	for {
+		q.mu.Lock()
+
+		if q.closed {
+			q.mu.Unlock()
+
+			return ErrClosedQueue
+		}
+
+		if !q.isFull() {
+			_ = q.queue.Enqueue(elem)
+			broadcast(&q.notEmpty)
+
+			q.mu.Unlock()
+
+			return nil
+		}
+
+		wait := waiter(&q.notFull)
+
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// TryDequeue removes the first element from the queue without blocking.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty but not closed, or the receiver is nil.
+//   - ErrClosedQueue: If the queue was closed and every element was drained.
+func (q *BlockingQueue[T]) TryDequeue() (T, error) {
+	if q == nil {
+		return *new(T), ErrEmptyQueue
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	elem, err := q.queue.Dequeue()
+	if err == nil {
+		broadcast(&q.notFull)
+
+		return elem, nil
+	}
+
+	if q.closed {
+		return *new(T), ErrClosedQueue
+	}
+
+	return *new(T), ErrEmptyQueue
+}
+
+// Dequeue removes the first element from the queue, blocking until an element
+// is available, the queue is closed and drained, or the context is done.
+//
+// Parameters:
+//   - ctx: The context of the operation.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If ctx is nil.
+//   - ErrClosedQueue: If the queue was closed and every element was drained.
+//   - any error returned by ctx.Err(): If the context is done before an element
+//     is available.
+func (q *BlockingQueue[T]) Dequeue(ctx context.Context) (T, error) {
+	if q == nil {
+		return *new(T), common.ErrNilReceiver
+	} else if ctx == nil {
+		return *new(T), common.NewErrNilParam("ctx")
+	}
+
+	for {
+		q.mu.Lock()
+
+		elem, err := q.queue.Dequeue()
+		if err == nil {
+			broadcast(&q.notFull)
+
+			q.mu.Unlock()
+
+			return elem, nil
+		}
+
+		if q.closed {
+			q.mu.Unlock()
+
+			return *new(T), ErrClosedQueue
+		}
+
+		wait := waiter(&q.notEmpty)
+
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Close closes the queue. Further calls to Enqueue fail with ErrClosedQueue
+// while Dequeue keeps returning the remaining elements until the queue is
+// drained, at which point it fails with ErrClosedQueue. Closing an already
+// closed queue does nothing.
+func (q *BlockingQueue[T]) Close() {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+
+	broadcast(&q.notEmpty)
+	broadcast(&q.notFull)
+}
+
+// IsClosed checks whether the queue was closed.
+//
+// Returns:
+//   - bool: True if the queue was closed, false otherwise.
+func (q *BlockingQueue[T]) IsClosed() bool {
+	if q == nil {
+		return false
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
@@ -0,0 +1,94 @@
+package listlike
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestBlockingQueue_ProducerConsumer tests that every element produced by
+// concurrent producers is consumed exactly once.
+func TestBlockingQueue_ProducerConsumer(t *testing.T) {
+	q, err := NewBlockingQueue[int](4)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	for p := 0; p < 4; p++ {
+		wg.Add(1)
+
+		go func(p int) {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				_ = q.Enqueue(ctx, p*100+i)
+			}
+		}(p)
+	}
+
+	go func() {
+		wg.Wait()
+		q.Close()
+	}()
+
+	seen := make(map[int]bool)
+
+	for {
+		elem, err := q.Dequeue(ctx)
+		if err == ErrClosedQueue {
+			break
+		} else if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		if seen[elem] {
+			t.Fatalf("element %d was dequeued twice", elem)
+		}
+
+		seen[elem] = true
+	}
+
+	if len(seen) != 400 {
+		t.Errorf("want 400, got %d", len(seen))
+	}
+}
+
+// TestBlockingQueue_Cancel tests that blocked operations return when their
+// context is done.
+func TestBlockingQueue_Cancel(t *testing.T) {
+	q, _ := NewBlockingQueue[int](1)
+
+	_ = q.TryEnqueue(1)
+
+	err := q.TryEnqueue(2)
+	if err != ErrFullQueue {
+		t.Errorf("want %v, got %v", ErrFullQueue, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = q.Enqueue(ctx, 2)
+	if err != context.DeadlineExceeded {
+		t.Errorf("want %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	_, _ = q.TryDequeue()
+
+	_, err = q.TryDequeue()
+	if err != ErrEmptyQueue {
+		t.Errorf("want %v, got %v", ErrEmptyQueue, err)
+	}
+
+	q.Close()
+
+	_, err = q.Dequeue(ctx)
+	if err != ErrClosedQueue {
+		t.Errorf("want %v, got %v", ErrClosedQueue, err)
+	}
+}
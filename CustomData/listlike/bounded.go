@@ -0,0 +1,406 @@
+package listlike
+
+import (
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// OverflowPolicy is what a bounded container does when an element is added
+// while it is full.
+type OverflowPolicy int
+
+const (
+	// OverflowError rejects the incoming element with an error.
+	OverflowError OverflowPolicy = iota
+
+	// DropOldest evicts the element that was added the earliest to make room
+	// for the incoming element.
+	DropOldest
+
+	// DropNewest evicts the incoming element; the container is left unchanged.
+	DropNewest
+)
+
+// isValid checks whether the policy is one of the known policies.
+func (p OverflowPolicy) isValid() bool {
+	return p >= OverflowError && p <= DropNewest
+}
+
+// checkBounded checks the parameters shared by the bounded constructors.
+//
+// Parameters:
+//   - capacity: The maximum number of elements.
+//   - policy: The overflow policy.
+//
+// Returns:
+//   - error: An error if the parameters are not valid.
+func checkBounded(capacity int, policy OverflowPolicy) error {
+	if capacity <= 0 {
+		return common.NewErrBadParam("capacity", "must be positive")
+	} else if !policy.isValid() {
+		return common.NewErrBadParam("policy", "is not a known overflow policy")
+	}
+
+	return nil
+}
+
+// BoundedStack is a stack that holds at most a fixed number of elements. What
+// happens when an element is pushed onto a full stack depends on its overflow
+// policy; the oldest element is the one at the bottom of the stack.
+type BoundedStack[T any] struct {
+	// deque holds the elements, with the top of the stack at the back.
+	deque Deque[T]
+
+	// capacity is the maximum number of elements.
+	capacity int
+
+	// policy is the overflow policy.
+	policy OverflowPolicy
+
+	// onEvict is called with every evicted element. May be nil.
+	onEvict func(elem T)
+}
+
+// Size implements the Lister interface.
+func (s BoundedStack[T]) Size() int {
+	return s.deque.Size()
+}
+
+// IsEmpty implements the Lister interface.
+func (s BoundedStack[T]) IsEmpty() bool {
+	return s.deque.IsEmpty()
+}
+
+// Reset implements the Lister interface.
+//
+// The capacity, policy and eviction callback are kept.
+func (s *BoundedStack[T]) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.deque.Reset()
+}
+
+// Cap returns the maximum number of elements of the stack.
+//
+// Returns:
+//   - int: The maximum number of elements. Never negative.
+func (s BoundedStack[T]) Cap() int {
+	return s.capacity
+}
+
+// NewBoundedStack creates a new, empty bounded stack.
+//
+// Parameters:
+//   - capacity: The maximum number of elements.
+//   - policy: What to do when an element is pushed onto a full stack.
+//   - onEvict: The function called with every evicted element. May be nil.
+//
+// Returns:
+//   - *BoundedStack[T]: The new stack. Nil if an error occurred.
+//   - error: An error if the stack could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If capacity is not positive or policy is not valid.
+func NewBoundedStack[T any](capacity int, policy OverflowPolicy, onEvict func(elem T)) (*BoundedStack[T], error) {
+	err := checkBounded(capacity, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoundedStack[T]{
+		capacity: capacity,
+		policy:   policy,
+		onEvict:  onEvict,
+	}, nil
+}
+
+// evict reports the evicted element to the callback, if any.
+func (s BoundedStack[T]) evict(elem T) {
+	if s.onEvict != nil {
+		s.onEvict(elem)
+	}
+}
+
+// Push adds an element to the stack.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the element could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrFullStack: If the stack is full and its policy is OverflowError.
+func (s *BoundedStack[T]) Push(elem T) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	if s.deque.Size() < s.capacity {
+		_ = s.deque.PushBack(elem)
+
+		return nil
+	}
+
+	switch s.policy {
+	case DropOldest:
+		oldest, _ := s.deque.PopFront()
+		_ = s.deque.PushBack(elem)
+
+		s.evict(oldest)
+	case DropNewest:
+		s.evict(elem)
+	default:
+		return ErrFullStack
+	}
+
+	return nil
+}
+
+// PushMany adds multiple elements to the stack as if Push was called on each
+// of them from the last to the first. If it has at least one element but the
+// receiver is nil, an error is returned. With the OverflowError policy, either
+// every element is added or none is.
+//
+// Parameters:
+//   - elems: The elements to add.
+//
+// Returns:
+//   - error: An error if the elements could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrFullStack: If the elements do not fit and the policy is OverflowError.
+func (s *BoundedStack[T]) PushMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	if s.policy == OverflowError && s.deque.Size()+len(elems) > s.capacity {
+		return ErrFullStack
+	}
+
+	for i := len(elems) - 1; i >= 0; i-- {
+		_ = s.Push(elems[i])
+	}
+
+	return nil
+}
+
+// Pop removes an element from the stack.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed from the stack.
+//
+// Errors:
+//   - ErrEmptyStack: If the stack is empty.
+func (s *BoundedStack[T]) Pop() (T, error) {
+	if s == nil {
+		return *new(T), ErrEmptyStack
+	}
+
+	elem, err := s.deque.PopBack()
+	if err != nil {
+		return *new(T), ErrEmptyStack
+	}
+
+	return elem, nil
+}
+
+// Peek returns the element at the top of the stack.
+//
+// Returns:
+//   - T: The element at the top of the stack.
+//   - error: An error if the stack is empty.
+//
+// Errors:
+//   - ErrEmptyStack: If the stack is empty.
+func (s BoundedStack[T]) Peek() (T, error) {
+	elem, err := s.deque.Back()
+	if err != nil {
+		return *new(T), ErrEmptyStack
+	}
+
+	return elem, nil
+}
+
+// BoundedQueue is a queue that holds at most a fixed number of elements. What
+// happens when an element is enqueued onto a full queue depends on its overflow
+// policy; the oldest element is the one at the start of the queue.
+type BoundedQueue[T any] struct {
+	// queue holds the elements.
+	queue Queue[T]
+
+	// capacity is the maximum number of elements.
+	capacity int
+
+	// policy is the overflow policy.
+	policy OverflowPolicy
+
+	// onEvict is called with every evicted element. May be nil.
+	onEvict func(elem T)
+}
+
+// Size implements the Lister interface.
+func (q BoundedQueue[T]) Size() int {
+	return q.queue.Size()
+}
+
+// IsEmpty implements the Lister interface.
+func (q BoundedQueue[T]) IsEmpty() bool {
+	return q.queue.IsEmpty()
+}
+
+// Reset implements the Lister interface.
+//
+// The capacity, policy and eviction callback are kept.
+func (q *BoundedQueue[T]) Reset() {
+	if q == nil {
+		return
+	}
+
+	q.queue.Reset()
+}
+
+// Cap returns the maximum number of elements of the queue.
+//
+// Returns:
+//   - int: The maximum number of elements. Never negative.
+func (q BoundedQueue[T]) Cap() int {
+	return q.capacity
+}
+
+// NewBoundedQueue creates a new, empty bounded queue.
+//
+// Parameters:
+//   - capacity: The maximum number of elements.
+//   - policy: What to do when an element is enqueued onto a full queue.
+//   - onEvict: The function called with every evicted element. May be nil.
+//
+// Returns:
+//   - *BoundedQueue[T]: The new queue. Nil if an error occurred.
+//   - error: An error if the queue could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If capacity is not positive or policy is not valid.
+func NewBoundedQueue[T any](capacity int, policy OverflowPolicy, onEvict func(elem T)) (*BoundedQueue[T], error) {
+	err := checkBounded(capacity, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoundedQueue[T]{
+		capacity: capacity,
+		policy:   policy,
+		onEvict:  onEvict,
+	}, nil
+}
+
+// evict reports the evicted element to the callback, if any.
+func (q BoundedQueue[T]) evict(elem T) {
+	if q.onEvict != nil {
+		q.onEvict(elem)
+	}
+}
+
+// Enqueue adds an element to the queue.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the element could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrFullQueue: If the queue is full and its policy is OverflowError.
+func (q *BoundedQueue[T]) Enqueue(elem T) error {
+	if q == nil {
+		return common.ErrNilReceiver
+	}
+
+	if q.queue.Size() < q.capacity {
+		_ = q.queue.Enqueue(elem)
+
+		return nil
+	}
+
+	switch q.policy {
+	case DropOldest:
+		oldest, _ := q.queue.Dequeue()
+		_ = q.queue.Enqueue(elem)
+
+		q.evict(oldest)
+	case DropNewest:
+		q.evict(elem)
+	default:
+		return ErrFullQueue
+	}
+
+	return nil
+}
+
+// EnqueueMany adds multiple elements to the queue as if Enqueue was called on
+// each of them in order. If it has at least one element but the receiver is nil,
+// an error is returned. With the OverflowError policy, either every element is
+// added or none is.
+//
+// Parameters:
+//   - elems: The elements to add.
+//
+// Returns:
+//   - error: An error if the elements could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrFullQueue: If the elements do not fit and the policy is OverflowError.
+func (q *BoundedQueue[T]) EnqueueMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	} else if q == nil {
+		return common.ErrNilReceiver
+	}
+
+	if q.policy == OverflowError && q.queue.Size()+len(elems) > q.capacity {
+		return ErrFullQueue
+	}
+
+	for _, elem := range elems {
+		_ = q.Enqueue(elem)
+	}
+
+	return nil
+}
+
+// Dequeue removes the first element from the queue.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed from the queue.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (q *BoundedQueue[T]) Dequeue() (T, error) {
+	if q == nil {
+		return *new(T), ErrEmptyQueue
+	}
+
+	return q.queue.Dequeue()
+}
+
+// First returns the element at the start of the queue.
+//
+// Returns:
+//   - T: The element at the start of the queue.
+//   - error: An error if the queue is empty.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (q BoundedQueue[T]) First() (T, error) {
+	return q.queue.First()
+}
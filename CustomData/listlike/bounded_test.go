@@ -0,0 +1,77 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestBoundedQueue tests the overflow policies of bounded queues.
+func TestBoundedQueue(t *testing.T) {
+	var evicted []int
+
+	onEvict := func(elem int) {
+		evicted = append(evicted, elem)
+	}
+
+	q, _ := NewBoundedQueue(2, DropOldest, onEvict)
+	_ = q.EnqueueMany([]int{1, 2, 3})
+
+	if !slices.Equal(slices.Collect(q.queue.All()), []int{2, 3}) {
+		t.Errorf("want %v, got %v", []int{2, 3}, slices.Collect(q.queue.All()))
+	}
+
+	q, _ = NewBoundedQueue(2, DropNewest, onEvict)
+	_ = q.EnqueueMany([]int{1, 2, 3})
+
+	if !slices.Equal(slices.Collect(q.queue.All()), []int{1, 2}) {
+		t.Errorf("want %v, got %v", []int{1, 2}, slices.Collect(q.queue.All()))
+	}
+
+	if !slices.Equal(evicted, []int{1, 3}) {
+		t.Errorf("want %v, got %v", []int{1, 3}, evicted)
+	}
+
+	q, _ = NewBoundedQueue[int](2, OverflowError, nil)
+
+	err := q.EnqueueMany([]int{1, 2, 3})
+	if err != ErrFullQueue {
+		t.Errorf("want %v, got %v", ErrFullQueue, err)
+	}
+
+	_, err = NewBoundedQueue[int](0, OverflowError, nil)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+}
+
+// TestBoundedStack tests the overflow policies of bounded stacks.
+func TestBoundedStack(t *testing.T) {
+	var evicted []int
+
+	s, _ := NewBoundedStack(2, DropOldest, func(elem int) {
+		evicted = append(evicted, elem)
+	})
+
+	for i := 1; i <= 3; i++ {
+		_ = s.Push(i)
+	}
+
+	if !slices.Equal(evicted, []int{1}) {
+		t.Errorf("want %v, got %v", []int{1}, evicted)
+	}
+
+	top, _ := s.Pop()
+	next, _ := s.Pop()
+
+	if top != 3 || next != 2 {
+		t.Errorf("want 3 and 2, got %d and %d", top, next)
+	}
+
+	s, _ = NewBoundedStack[int](1, OverflowError, nil)
+	_ = s.Push(1)
+
+	err := s.Push(2)
+	if err != ErrFullStack {
+		t.Errorf("want %v, got %v", ErrFullStack, err)
+	}
+}
@@ -0,0 +1,50 @@
+package listlike_test
+
+import (
+	"testing"
+
+	"github.com/PlayerR9/mysd-lib/CustomData/containertest"
+	"github.com/PlayerR9/mysd-lib/CustomData/listlike"
+)
+
+// TestStackers runs the Stacker suite against every stack of the package.
+func TestStackers(t *testing.T) {
+	elems := []int{1, 2, 3, 4}
+
+	t.Run("ArrayStack", func(t *testing.T) {
+		containertest.TestStacker(t, func() listlike.Stacker[int] {
+			return listlike.NewStack[int](nil)
+		}, elems)
+	})
+
+	t.Run("RefusableStack", func(t *testing.T) {
+		containertest.TestStacker(t, func() listlike.Stacker[int] {
+			return listlike.NewRefusableStack[int](nil)
+		}, elems)
+	})
+
+	t.Run("BoundedStack", func(t *testing.T) {
+		containertest.TestStacker(t, func() listlike.Stacker[int] {
+			s, _ := listlike.NewBoundedStack[int](len(elems), listlike.OverflowError, nil)
+			return s
+		}, elems)
+	})
+}
+
+// TestQueuers runs the Queuer suite against every queue of the package.
+func TestQueuers(t *testing.T) {
+	elems := []int{1, 2, 3, 4}
+
+	t.Run("Queue", func(t *testing.T) {
+		containertest.TestQueuer(t, func() listlike.Queuer[int] {
+			return listlike.NewQueue[int](nil)
+		}, elems)
+	})
+
+	t.Run("BoundedQueue", func(t *testing.T) {
+		containertest.TestQueuer(t, func() listlike.Queuer[int] {
+			q, _ := listlike.NewBoundedQueue[int](len(elems), listlike.OverflowError, nil)
+			return q
+		}, elems)
+	})
+}
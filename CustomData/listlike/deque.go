@@ -0,0 +1,292 @@
+package listlike
+
+import (
+	"iter"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Deque is a double-ended queue backed by a growable circular buffer. An empty
+// deque can either be created with the `var deque Deque[T]` syntax or with the
+// `new(Deque[T])` constructor.
+type Deque[T any] struct {
+	// buf is the circular buffer.
+	buf []T
+
+	// head is the index of the front element in buf.
+	head int
+
+	// size is the number of elements in the deque.
+	size int
+}
+
+// Size implements the Lister interface.
+func (d Deque[T]) Size() int {
+	return d.size
+}
+
+// IsEmpty implements the Lister interface.
+func (d Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Reset implements the Lister interface.
+func (d *Deque[T]) Reset() {
+	if d == nil {
+		return
+	}
+
+	if len(d.buf) > 0 {
+		clear(d.buf)
+		d.buf = nil
+	}
+
+	d.head = 0
+	d.size = 0
+}
+
+// NewDeque creates a new deque from a slice. The first element of the slice
+// will be at the front of the deque.
+//
+// Parameters:
+//   - elems: The elements to add to the deque.
+//
+// Returns:
+//   - *Deque[T]: The new deque. Never returns nil.
+func NewDeque[T any](elems []T) *Deque[T] {
+	if len(elems) == 0 {
+		return &Deque[T]{}
+	}
+
+	buf := make([]T, len(elems))
+	copy(buf, elems)
+
+	return &Deque[T]{
+		buf:  buf,
+		size: len(elems),
+	}
+}
+
+// index returns the position in buf of the i-th element of the deque.
+//
+// Parameters:
+//   - i: The index of the element. Assumed to be in [0, d.size].
+//
+// Returns:
+//   - int: The position in buf.
+func (d Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// resize moves the elements of the deque into a new buffer of the given
+// capacity. The capacity must be at least d.size.
+//
+// Parameters:
+//   - capacity: The capacity of the new buffer.
+func (d *Deque[T]) resize(capacity int) {
+	var buf []T
+
+	if capacity > 0 {
+		buf = make([]T, capacity)
+
+		if d.size > 0 {
+			n := copy(buf, d.buf[d.head:min(d.head+d.size, len(d.buf))])
+			copy(buf[n:], d.buf[:d.size-n])
+		}
+	}
+
+	clear(d.buf)
+
+	d.buf = buf
+	d.head = 0
+}
+
+// grow makes sure the buffer can hold one more element.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	d.resize(max(2*len(d.buf), minQueueCap))
+}
+
+// shrink halves the buffer when the deque occupies a quarter of it or less.
+func (d *Deque[T]) shrink() {
+	half := len(d.buf) / 2
+
+	if half < minQueueCap || d.size > len(d.buf)/4 {
+		return
+	}
+
+	d.resize(half)
+}
+
+// PushFront adds an element to the front of the deque.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the receiver is nil.
+func (d *Deque[T]) PushFront(elem T) error {
+	if d == nil {
+		return common.ErrNilReceiver
+	}
+
+	d.grow()
+
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = elem
+	d.size++
+
+	return nil
+}
+
+// PushBack adds an element to the back of the deque.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the receiver is nil.
+func (d *Deque[T]) PushBack(elem T) error {
+	if d == nil {
+		return common.ErrNilReceiver
+	}
+
+	d.grow()
+
+	d.buf[d.index(d.size)] = elem
+	d.size++
+
+	return nil
+}
+
+// PopFront removes the element at the front of the deque.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed from the deque.
+//
+// Errors:
+//   - ErrEmptyDeque: If the deque is empty.
+func (d *Deque[T]) PopFront() (T, error) {
+	if d == nil || d.size == 0 {
+		return *new(T), ErrEmptyDeque
+	}
+
+	elem := d.buf[d.head]
+	d.buf[d.head] = *new(T)
+
+	d.head = d.index(1)
+	d.size--
+
+	d.shrink()
+
+	return elem, nil
+}
+
+// PopBack removes the element at the back of the deque.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed from the deque.
+//
+// Errors:
+//   - ErrEmptyDeque: If the deque is empty.
+func (d *Deque[T]) PopBack() (T, error) {
+	if d == nil || d.size == 0 {
+		return *new(T), ErrEmptyDeque
+	}
+
+	pos := d.index(d.size - 1)
+
+	elem := d.buf[pos]
+	d.buf[pos] = *new(T)
+
+	d.size--
+
+	d.shrink()
+
+	return elem, nil
+}
+
+// Front returns the element at the front of the deque.
+//
+// Returns:
+//   - T: The element at the front of the deque.
+//   - error: An error if the deque is empty.
+//
+// Errors:
+//   - ErrEmptyDeque: If the deque is empty.
+func (d Deque[T]) Front() (T, error) {
+	if d.size == 0 {
+		return *new(T), ErrEmptyDeque
+	}
+
+	return d.buf[d.head], nil
+}
+
+// Back returns the element at the back of the deque.
+//
+// Returns:
+//   - T: The element at the back of the deque.
+//   - error: An error if the deque is empty.
+//
+// Errors:
+//   - ErrEmptyDeque: If the deque is empty.
+func (d Deque[T]) Back() (T, error) {
+	if d.size == 0 {
+		return *new(T), ErrEmptyDeque
+	}
+
+	return d.buf[d.index(d.size-1)], nil
+}
+
+// At returns the element at the given index, where index 0 is the front of
+// the deque.
+//
+// Parameters:
+//   - idx: The index of the element.
+//
+// Returns:
+//   - T: The element at the given index.
+//   - error: An error if the index is out of bounds.
+//
+// Errors:
+//   - ErrOutOfBounds: If idx is not in [0, Size()).
+func (d Deque[T]) At(idx int) (T, error) {
+	if idx < 0 || idx >= d.size {
+		return *new(T), ErrOutOfBounds
+	}
+
+	return d.buf[d.index(idx)], nil
+}
+
+// All returns an iterator over the elements of the deque from front to back.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the deque. Never returns nil.
+func (d Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the deque from back to front.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the deque. Never returns nil.
+func (d Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
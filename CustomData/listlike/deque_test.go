@@ -0,0 +1,65 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestDeque tests pushing and popping at both ends of the deque.
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+
+	for i := 0; i < 20; i++ {
+		_ = d.PushBack(i)
+		_ = d.PushFront(-i - 1)
+	}
+
+	if d.Size() != 40 {
+		t.Fatalf("want 40, got %d", d.Size())
+	}
+
+	front, _ := d.Front()
+	if front != -20 {
+		t.Errorf("want -20, got %d", front)
+	}
+
+	back, _ := d.Back()
+	if back != 19 {
+		t.Errorf("want 19, got %d", back)
+	}
+
+	elem, err := d.At(20)
+	if err != nil || elem != 0 {
+		t.Errorf("want 0, got %d (%v)", elem, err)
+	}
+
+	_, err = d.At(40)
+	if err != ErrOutOfBounds {
+		t.Errorf("want %v, got %v", ErrOutOfBounds, err)
+	}
+
+	forward := slices.Collect(d.All())
+	backward := slices.Collect(d.Backward())
+	slices.Reverse(backward)
+
+	if !slices.Equal(forward, backward) {
+		t.Errorf("want %v, got %v", forward, backward)
+	}
+
+	for i := 0; i < 20; i++ {
+		elem, _ := d.PopBack()
+		if elem != 19-i {
+			t.Fatalf("want %d, got %d", 19-i, elem)
+		}
+
+		elem, _ = d.PopFront()
+		if elem != -20+i {
+			t.Fatalf("want %d, got %d", -20+i, elem)
+		}
+	}
+
+	_, err = d.PopFront()
+	if err != ErrEmptyDeque {
+		t.Errorf("want %v, got %v", ErrEmptyDeque, err)
+	}
+}
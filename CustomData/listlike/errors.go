@@ -18,15 +18,121 @@ var (
 	ErrEmptyQueue error
 
 	// ErrCannotPush occurs when a push operation is called on a refusable stack that
-	// was not accepted nor refused yet. This error can be checked with the == operator.
+	// was not accepted nor refused yet and that has no active savepoint. This error can
+	// be checked with the == operator.
 	//
 	// Format:
 	// 	"cannot push elements: stack not accepted nor refused"
 	ErrCannotPush error
+
+	// ErrFullStack occurs when a push operation is called on a bounded stack that
+	// has reached its capacity. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"full stack"
+	ErrFullStack error
+
+	// ErrEmptyDeque occurs when a pop or peek operation is called on an empty deque.
+	// This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"empty deque"
+	ErrEmptyDeque error
+
+	// ErrOutOfBounds occurs when an index is not within the bounds of a list-like
+	// data structure. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"index out of bounds"
+	ErrOutOfBounds error
+
+	// ErrInvalidHandle occurs when a handle does not refer to an element that is
+	// currently in the container. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"handle is not valid"
+	ErrInvalidHandle error
+
+	// ErrFullQueue occurs when a push operation is called on a bounded queue that
+	// has reached its capacity. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"full queue"
+	ErrFullQueue error
+
+	// ErrClosedQueue occurs when a push operation is called on a closed queue, or when
+	// a pop operation is called on a closed queue that has been drained. This error can
+	// be checked with the == operator.
+	//
+	// Format:
+	// 	"closed queue"
+	ErrClosedQueue error
+
+	// ErrInvalidSavepoint occurs when a savepoint was not created by the stack or was
+	// already committed or rolled back. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"savepoint is not valid"
+	ErrInvalidSavepoint error
+
+	// ErrNoTransaction occurs when a transaction is ended on a history that has no
+	// open transaction. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"no open transaction"
+	ErrNoTransaction error
+
+	// ErrOpenTransaction occurs when an undo or redo operation is called on a history
+	// that has an open transaction. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"a transaction is still open"
+	ErrOpenTransaction error
+
+	// ErrNoAutomaton occurs when an automaton was expected but none was provided.
+	// This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"no automaton was provided"
+	ErrNoAutomaton error
+
+	// ErrBadlyImplemented occurs when an automaton does not respect the Aut contract.
+	// This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"automaton is implemented incorrectly"
+	ErrBadlyImplemented error
+
+	// ErrTerminal occurs when an automaton in a terminal state is stepped without
+	// being reset first. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"automaton is in a terminal state"
+	ErrTerminal error
+
+	// ErrPanicked occurs when an automaton panics while it is run. This error
+	// wraps the panic value and can be checked with errors.Is.
+	//
+	// Format:
+	// 	"automaton panicked: <value>"
+	ErrPanicked error
 )
 
 func init() {
 	ErrEmptyStack = errors.New("empty stack")
 	ErrEmptyQueue = errors.New("empty queue")
 	ErrCannotPush = errors.New("cannot push elements: stack not accepted nor refused")
+	ErrFullStack = errors.New("full stack")
+	ErrEmptyDeque = errors.New("empty deque")
+	ErrOutOfBounds = errors.New("index out of bounds")
+	ErrInvalidHandle = errors.New("handle is not valid")
+	ErrFullQueue = errors.New("full queue")
+	ErrClosedQueue = errors.New("closed queue")
+	ErrInvalidSavepoint = errors.New("savepoint is not valid")
+	ErrNoTransaction = errors.New("no open transaction")
+	ErrOpenTransaction = errors.New("a transaction is still open")
+	ErrNoAutomaton = errors.New("no automaton was provided")
+	ErrBadlyImplemented = errors.New("automaton is implemented incorrectly")
+	ErrTerminal = errors.New("automaton is in a terminal state")
+	ErrPanicked = errors.New("automaton panicked")
 }
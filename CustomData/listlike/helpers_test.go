@@ -0,0 +1,30 @@
+package listlike
+
+import (
+	"testing"
+)
+
+// TestReset tests the Reset helpers.
+func TestReset(t *testing.T) {
+	s := NewStack([]int{1, 2, 3})
+
+	Reset(s)
+	if !s.IsEmpty() {
+		t.Errorf("want empty stack, got size %d", s.Size())
+	}
+
+	var nil_stack *ArrayStack[int]
+	Reset(nil_stack)
+
+	Reset[Lister](nil)
+
+	elems := []int{1, 2, 3}
+	backing := elems
+
+	ResetSlice(&elems)
+	if elems != nil || backing[0] != 0 {
+		t.Errorf("want a cleared nil slice, got %v (backing %v)", elems, backing)
+	}
+
+	ResetSlice[int](nil)
+}
@@ -0,0 +1,320 @@
+package listlike
+
+import (
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Undoable is an operation that can be undone.
+type Undoable interface {
+	// Do applies the operation.
+	//
+	// Returns:
+	//   - error: An error if the operation could not be applied.
+	Do() error
+
+	// Undo reverts the operation.
+	//
+	// Returns:
+	//   - error: An error if the operation could not be reverted.
+	Undo() error
+}
+
+// History is an undo/redo history of operations. Operations are recorded in
+// groups: every call to Do outside of a transaction is its own group, while
+// every call to Do between Begin and End belongs to the same group. Undo and
+// Redo always act on whole groups.
+type History[T any] struct {
+	// undo holds the groups that can be undone; the most recent one at the top.
+	undo Stacker[[]T]
+
+	// redo holds the groups that can be redone; the most recently undone one
+	// at the top.
+	redo Stacker[[]T]
+
+	// apply applies an operation.
+	apply func(op T) error
+
+	// revert reverts an operation.
+	revert func(op T) error
+
+	// depth is the number of open transactions.
+	depth int
+
+	// group holds the operations of the open transaction.
+	group []T
+}
+
+// newHistoryStack returns a new stack of groups of at most limit elements, or
+// an unlimited stack if limit is 0.
+func newHistoryStack[T any](limit int) Stacker[[]T] {
+	if limit == 0 {
+		return new(ArrayStack[[]T])
+	}
+
+	s, _ := NewBoundedStack[[]T](limit, DropOldest, nil)
+
+	return s
+}
+
+// NewHistory creates a new, empty history.
+//
+// Parameters:
+//   - apply: The function that applies an operation.
+//   - revert: The function that reverts an operation.
+//   - limit: The maximum number of groups that can be undone; the oldest groups
+//     are forgotten first. 0 means unlimited.
+//
+// Returns:
+//   - *History[T]: The new history. Nil if an error occurred.
+//   - error: An error if the history could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If apply or revert is nil, or limit is negative.
+func NewHistory[T any](apply, revert func(op T) error, limit int) (*History[T], error) {
+	if apply == nil {
+		return nil, common.NewErrNilParam("apply")
+	} else if revert == nil {
+		return nil, common.NewErrNilParam("revert")
+	} else if limit < 0 {
+		return nil, common.NewErrBadParam("limit", "must be non-negative")
+	}
+
+	return &History[T]{
+		undo:   newHistoryStack[T](limit),
+		redo:   newHistoryStack[T](limit),
+		apply:  apply,
+		revert: revert,
+	}, nil
+}
+
+// NewUndoableHistory creates a new, empty history of Undoable operations.
+//
+// Parameters:
+//   - limit: The maximum number of groups that can be undone; the oldest groups
+//     are forgotten first. 0 means unlimited.
+//
+// Returns:
+//   - *History[Undoable]: The new history. Nil if an error occurred.
+//   - error: An error if the history could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If limit is negative.
+func NewUndoableHistory(limit int) (*History[Undoable], error) {
+	return NewHistory(Undoable.Do, Undoable.Undo, limit)
+}
+
+// Size implements the Lister interface.
+//
+// The size of a history is the number of groups that can be undone.
+func (h History[T]) Size() int {
+	if h.undo == nil {
+		return 0
+	}
+
+	return h.undo.Size()
+}
+
+// IsEmpty implements the Lister interface.
+func (h History[T]) IsEmpty() bool {
+	return h.Size() == 0
+}
+
+// Reset implements the Lister interface.
+//
+// Reset forgets every recorded operation and the open transaction, if any,
+// without reverting them.
+func (h *History[T]) Reset() {
+	if h == nil || h.undo == nil {
+		return
+	}
+
+	h.undo.Reset()
+	h.redo.Reset()
+
+	clear(h.group)
+	h.group = nil
+	h.depth = 0
+}
+
+// CanUndo checks whether there is a group that can be undone.
+//
+// Returns:
+//   - bool: True if Undo would undo something, false otherwise.
+func (h History[T]) CanUndo() bool {
+	return h.depth == 0 && h.undo != nil && !h.undo.IsEmpty()
+}
+
+// CanRedo checks whether there is a group that can be redone.
+//
+// Returns:
+//   - bool: True if Redo would redo something, false otherwise.
+func (h History[T]) CanRedo() bool {
+	return h.depth == 0 && h.redo != nil && !h.redo.IsEmpty()
+}
+
+// Do applies the operation and records it. Everything that could be redone is
+// forgotten. If the operation fails, it is not recorded.
+//
+// Parameters:
+//   - op: The operation to apply.
+//
+// Returns:
+//   - error: An error if the operation could not be applied.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the history was not created with NewHistory.
+//   - any error returned by the apply function.
+func (h *History[T]) Do(op T) error {
+	if h == nil {
+		return common.ErrNilReceiver
+	} else if h.undo == nil {
+		return common.NewErrNilParam("apply")
+	}
+
+	err := h.apply(op)
+	if err != nil {
+		return err
+	}
+
+	h.redo.Reset()
+
+	if h.depth > 0 {
+		h.group = append(h.group, op)
+	} else {
+		_ = h.undo.Push([]T{op})
+	}
+
+	return nil
+}
+
+// Begin opens a transaction. Every operation done until the matching call to End
+// is recorded in the same group. Transactions can be nested, in which case the
+// group is closed by the outermost End.
+//
+// Returns:
+//   - error: An error if the receiver is nil.
+func (h *History[T]) Begin() error {
+	if h == nil {
+		return common.ErrNilReceiver
+	}
+
+	h.depth++
+
+	return nil
+}
+
+// End closes the innermost open transaction. When the outermost transaction is
+// closed, its operations are recorded as a single group, unless there are none.
+//
+// Returns:
+//   - error: An error if the transaction could not be closed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrNoTransaction: If there is no open transaction.
+func (h *History[T]) End() error {
+	if h == nil {
+		return common.ErrNilReceiver
+	} else if h.depth == 0 {
+		return ErrNoTransaction
+	}
+
+	h.depth--
+
+	if h.depth > 0 || len(h.group) == 0 {
+		return nil
+	}
+
+	_ = h.undo.Push(h.group)
+	h.group = nil
+
+	return nil
+}
+
+// Undo reverts the most recent group of operations, from the last operation to
+// the first. If an operation fails to be reverted, the operations that were
+// reverted can be redone while the remaining ones can still be undone.
+//
+// Returns:
+//   - error: An error if the group could not be undone.
+//
+// Errors:
+//   - ErrEmptyStack: If there is nothing to undo.
+//   - ErrOpenTransaction: If a transaction is open.
+//   - any error returned by the revert function.
+func (h *History[T]) Undo() error {
+	if h == nil || h.undo == nil {
+		return ErrEmptyStack
+	} else if h.depth > 0 {
+		return ErrOpenTransaction
+	}
+
+	group, err := h.undo.Pop()
+	if err != nil {
+		return err
+	}
+
+	for i := len(group) - 1; i >= 0; i-- {
+		err := h.revert(group[i])
+		if err == nil {
+			continue
+		}
+
+		if i+1 < len(group) {
+			_ = h.redo.Push(group[i+1:])
+		}
+
+		_ = h.undo.Push(group[: i+1 : i+1])
+
+		return err
+	}
+
+	_ = h.redo.Push(group)
+
+	return nil
+}
+
+// Redo applies again the most recently undone group of operations, from the
+// first operation to the last. If an operation fails to be applied, the
+// operations that were applied can be undone while the remaining ones can
+// still be redone.
+//
+// Returns:
+//   - error: An error if the group could not be redone.
+//
+// Errors:
+//   - ErrEmptyStack: If there is nothing to redo.
+//   - ErrOpenTransaction: If a transaction is open.
+//   - any error returned by the apply function.
+func (h *History[T]) Redo() error {
+	if h == nil || h.redo == nil {
+		return ErrEmptyStack
+	} else if h.depth > 0 {
+		return ErrOpenTransaction
+	}
+
+	group, err := h.redo.Pop()
+	if err != nil {
+		return err
+	}
+
+	for i, op := range group {
+		err := h.apply(op)
+		if err == nil {
+			continue
+		}
+
+		if i > 0 {
+			_ = h.undo.Push(group[:i:i])
+		}
+
+		_ = h.redo.Push(group[i:])
+
+		return err
+	}
+
+	_ = h.undo.Push(group)
+
+	return nil
+}
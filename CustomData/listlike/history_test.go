@@ -0,0 +1,75 @@
+package listlike
+
+import (
+	"testing"
+)
+
+// counterOp adds delta to a counter.
+type counterOp struct {
+	counter *int
+	delta   int
+}
+
+// Do implements the Undoable interface.
+func (op counterOp) Do() error {
+	*op.counter += op.delta
+	return nil
+}
+
+// Undo implements the Undoable interface.
+func (op counterOp) Undo() error {
+	*op.counter -= op.delta
+	return nil
+}
+
+// TestHistory tests undoing and redoing groups of operations.
+func TestHistory(t *testing.T) {
+	var counter int
+
+	h, err := NewUndoableHistory(2)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	_ = h.Do(counterOp{&counter, 1})
+
+	_ = h.Begin()
+	_ = h.Do(counterOp{&counter, 10})
+	_ = h.Do(counterOp{&counter, 100})
+
+	err = h.Undo()
+	if err != ErrOpenTransaction {
+		t.Errorf("want %v, got %v", ErrOpenTransaction, err)
+	}
+
+	_ = h.End()
+	_ = h.Do(counterOp{&counter, 1000})
+
+	if counter != 1111 || h.Size() != 2 {
+		t.Fatalf("want 1111 and 2 groups, got %d and %d", counter, h.Size())
+	}
+
+	_ = h.Undo()
+	_ = h.Undo()
+
+	if counter != 1 {
+		t.Errorf("want 1, got %d", counter)
+	}
+
+	err = h.Undo()
+	if err != ErrEmptyStack {
+		t.Errorf("want %v, got %v", ErrEmptyStack, err)
+	}
+
+	_ = h.Redo()
+
+	if counter != 111 || !h.CanRedo() || !h.CanUndo() {
+		t.Errorf("want 111 with both undo and redo available, got %d", counter)
+	}
+
+	_ = h.Do(counterOp{&counter, 5})
+
+	if h.CanRedo() {
+		t.Errorf("want redo history to be cleared")
+	}
+}
@@ -0,0 +1,711 @@
+package listlike
+
+import (
+	"iter"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Element is an element of a List.
+type Element[T any] struct {
+	// value is the value stored in the element.
+	value T
+
+	// next is the next element. When the element is removed, it keeps pointing
+	// to its former successor so that cursors can step off of it.
+	next *Element[T]
+
+	// prev is the previous element. When the element is removed, it keeps
+	// pointing to its former predecessor so that cursors can step off of it.
+	prev *Element[T]
+
+	// list is the list the element belongs to. Nil if the element was removed.
+	list *List[T]
+}
+
+// Value returns the value stored in the element.
+//
+// Returns:
+//   - T: The value. The zero value if the receiver is nil.
+func (e *Element[T]) Value() T {
+	if e == nil {
+		return *new(T)
+	}
+
+	return e.value
+}
+
+// SetValue replaces the value stored in the element. Does nothing if the
+// receiver is nil.
+//
+// Parameters:
+//   - value: The new value.
+func (e *Element[T]) SetValue(value T) {
+	if e == nil {
+		return
+	}
+
+	e.value = value
+}
+
+// Next returns the next element of the list.
+//
+// Returns:
+//   - *Element[T]: The next element. Nil if e is the last element or is not in a list.
+func (e *Element[T]) Next() *Element[T] {
+	if e == nil || e.list == nil || e.next == &e.list.root {
+		return nil
+	}
+
+	return e.next
+}
+
+// Prev returns the previous element of the list.
+//
+// Returns:
+//   - *Element[T]: The previous element. Nil if e is the first element or is not in a list.
+func (e *Element[T]) Prev() *Element[T] {
+	if e == nil || e.list == nil || e.prev == &e.list.root {
+		return nil
+	}
+
+	return e.prev
+}
+
+// List is a doubly linked list. An empty list can either be created with the
+// `var list List[T]` syntax or with the `new(List[T])` constructor.
+//
+// A List must not be copied after first use.
+type List[T any] struct {
+	// root is the sentinel element; root.next is the front and root.prev is
+	// the back of the list.
+	root Element[T]
+
+	// size is the number of elements in the list.
+	size int
+}
+
+// lazyInit initializes the sentinel of a zero-value list.
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+		l.root.list = l
+	}
+}
+
+// Size implements the Lister interface.
+func (l *List[T]) Size() int {
+	if l == nil {
+		return 0
+	}
+
+	return l.size
+}
+
+// IsEmpty implements the Lister interface.
+func (l *List[T]) IsEmpty() bool {
+	return l.Size() == 0
+}
+
+// Reset implements the Lister interface.
+//
+// Every element is removed from the list. Cursors over the list end up past
+// its end.
+func (l *List[T]) Reset() {
+	if l == nil || l.size == 0 {
+		return
+	}
+
+	for e := l.root.next; e != &l.root; e = e.next {
+		e.list = nil
+	}
+
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+}
+
+// NewList creates a new list from a slice. The first element of the slice will
+// be at the front of the list.
+//
+// Parameters:
+//   - elems: The elements to add to the list.
+//
+// Returns:
+//   - *List[T]: The new list. Never returns nil.
+func NewList[T any](elems []T) *List[T] {
+	l := new(List[T])
+	l.lazyInit()
+
+	for _, elem := range elems {
+		l.insertAfter(elem, l.root.prev)
+	}
+
+	return l
+}
+
+// Front returns the first element of the list.
+//
+// Returns:
+//   - *Element[T]: The first element. Nil if the list is empty.
+func (l *List[T]) Front() *Element[T] {
+	if l == nil || l.size == 0 {
+		return nil
+	}
+
+	return l.root.next
+}
+
+// Back returns the last element of the list.
+//
+// Returns:
+//   - *Element[T]: The last element. Nil if the list is empty.
+func (l *List[T]) Back() *Element[T] {
+	if l == nil || l.size == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
+
+// owns checks whether the element belongs to the list.
+func (l *List[T]) owns(e *Element[T]) bool {
+	return e != nil && e.list == l
+}
+
+// link inserts e after at.
+func (l *List[T]) link(e, at *Element[T]) {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// unlink removes e from its neighbours. e keeps its own pointers.
+func (l *List[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+// insertAfter inserts a new element with the given value after at.
+func (l *List[T]) insertAfter(value T, at *Element[T]) *Element[T] {
+	e := &Element[T]{
+		value: value,
+		list:  l,
+	}
+
+	l.link(e, at)
+	l.size++
+
+	return e
+}
+
+// PushFront inserts a new element at the front of the list.
+//
+// Parameters:
+//   - value: The value of the new element.
+//
+// Returns:
+//   - *Element[T]: The new element. Nil if an error occurred.
+//   - error: An error if the receiver is nil.
+func (l *List[T]) PushFront(value T) (*Element[T], error) {
+	if l == nil {
+		return nil, common.ErrNilReceiver
+	}
+
+	l.lazyInit()
+
+	return l.insertAfter(value, &l.root), nil
+}
+
+// PushBack inserts a new element at the back of the list.
+//
+// Parameters:
+//   - value: The value of the new element.
+//
+// Returns:
+//   - *Element[T]: The new element. Nil if an error occurred.
+//   - error: An error if the receiver is nil.
+func (l *List[T]) PushBack(value T) (*Element[T], error) {
+	if l == nil {
+		return nil, common.ErrNilReceiver
+	}
+
+	l.lazyInit()
+
+	return l.insertAfter(value, l.root.prev), nil
+}
+
+// InsertBefore inserts a new element right before mark.
+//
+// Parameters:
+//   - value: The value of the new element.
+//   - mark: The element before which to insert.
+//
+// Returns:
+//   - *Element[T]: The new element. Nil if an error occurred.
+//   - error: An error if the element could not be inserted.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If mark does not belong to the list.
+func (l *List[T]) InsertBefore(value T, mark *Element[T]) (*Element[T], error) {
+	if l == nil {
+		return nil, common.ErrNilReceiver
+	} else if !l.owns(mark) {
+		return nil, ErrInvalidHandle
+	}
+
+	return l.insertAfter(value, mark.prev), nil
+}
+
+// InsertAfter inserts a new element right after mark.
+//
+// Parameters:
+//   - value: The value of the new element.
+//   - mark: The element after which to insert.
+//
+// Returns:
+//   - *Element[T]: The new element. Nil if an error occurred.
+//   - error: An error if the element could not be inserted.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If mark does not belong to the list.
+func (l *List[T]) InsertAfter(value T, mark *Element[T]) (*Element[T], error) {
+	if l == nil {
+		return nil, common.ErrNilReceiver
+	} else if !l.owns(mark) {
+		return nil, ErrInvalidHandle
+	}
+
+	return l.insertAfter(value, mark), nil
+}
+
+// Remove removes the element from the list. Cursors positioned on the element
+// remain usable and step to its former neighbours.
+//
+// Parameters:
+//   - e: The element to remove.
+//
+// Returns:
+//   - T: The value of the removed element.
+//   - error: An error if the element could not be removed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If e does not belong to the list.
+func (l *List[T]) Remove(e *Element[T]) (T, error) {
+	if l == nil {
+		return *new(T), common.ErrNilReceiver
+	} else if !l.owns(e) {
+		return *new(T), ErrInvalidHandle
+	}
+
+	l.unlink(e)
+	e.list = nil
+	l.size--
+
+	return e.value, nil
+}
+
+// MoveToFront moves the element to the front of the list.
+//
+// Parameters:
+//   - e: The element to move.
+//
+// Returns:
+//   - error: An error if the element could not be moved.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If e does not belong to the list.
+func (l *List[T]) MoveToFront(e *Element[T]) error {
+	if l == nil {
+		return common.ErrNilReceiver
+	} else if !l.owns(e) {
+		return ErrInvalidHandle
+	}
+
+	if l.root.next != e {
+		l.unlink(e)
+		l.link(e, &l.root)
+	}
+
+	return nil
+}
+
+// MoveToBack moves the element to the back of the list.
+//
+// Parameters:
+//   - e: The element to move.
+//
+// Returns:
+//   - error: An error if the element could not be moved.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If e does not belong to the list.
+func (l *List[T]) MoveToBack(e *Element[T]) error {
+	if l == nil {
+		return common.ErrNilReceiver
+	} else if !l.owns(e) {
+		return ErrInvalidHandle
+	}
+
+	if l.root.prev != e {
+		l.unlink(e)
+		l.link(e, l.root.prev)
+	}
+
+	return nil
+}
+
+// splice moves every element of other right after at. other ends up empty.
+func (l *List[T]) splice(other *List[T], at *Element[T]) {
+	if other.size == 0 {
+		return
+	}
+
+	for e := other.root.next; e != &other.root; e = e.next {
+		e.list = l
+	}
+
+	first := other.root.next
+	last := other.root.prev
+
+	first.prev = at
+	last.next = at.next
+	at.next.prev = last
+	at.next = first
+
+	l.size += other.size
+
+	other.root.next = &other.root
+	other.root.prev = &other.root
+	other.size = 0
+}
+
+// checkSplice checks the parameters shared by the splice methods.
+func (l *List[T]) checkSplice(other *List[T]) error {
+	if l == nil {
+		return common.ErrNilReceiver
+	} else if other == l {
+		return common.NewErrBadParam("other", "must not be the receiver")
+	}
+
+	l.lazyInit()
+
+	return nil
+}
+
+// SpliceFront moves every element of other to the front of the list, keeping
+// their order. other ends up empty and its elements now belong to the list.
+//
+// Parameters:
+//   - other: The list whose elements are moved.
+//
+// Returns:
+//   - error: An error if the elements could not be moved.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If other is the receiver.
+func (l *List[T]) SpliceFront(other *List[T]) error {
+	err := l.checkSplice(other)
+	if err != nil || other == nil {
+		return err
+	}
+
+	l.splice(other, &l.root)
+
+	return nil
+}
+
+// SpliceBack moves every element of other to the back of the list, keeping
+// their order. other ends up empty and its elements now belong to the list.
+//
+// Parameters:
+//   - other: The list whose elements are moved.
+//
+// Returns:
+//   - error: An error if the elements could not be moved.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If other is the receiver.
+func (l *List[T]) SpliceBack(other *List[T]) error {
+	err := l.checkSplice(other)
+	if err != nil || other == nil {
+		return err
+	}
+
+	l.splice(other, l.root.prev)
+
+	return nil
+}
+
+// SpliceAfter moves every element of other right after mark, keeping their
+// order. other ends up empty and its elements now belong to the list.
+//
+// Parameters:
+//   - mark: The element after which to move the elements.
+//   - other: The list whose elements are moved.
+//
+// Returns:
+//   - error: An error if the elements could not be moved.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If other is the receiver.
+//   - ErrInvalidHandle: If mark does not belong to the list.
+func (l *List[T]) SpliceAfter(mark *Element[T], other *List[T]) error {
+	err := l.checkSplice(other)
+	if err != nil {
+		return err
+	} else if !l.owns(mark) {
+		return ErrInvalidHandle
+	}
+
+	if other != nil {
+		l.splice(other, mark)
+	}
+
+	return nil
+}
+
+// All returns an iterator over the values of the list from front to back.
+//
+// Returns:
+//   - iter.Seq[T]: The values of the list. Never returns nil.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the list from back to front.
+//
+// Returns:
+//   - iter.Seq[T]: The values of the list. Never returns nil.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor is a position in a List. A cursor is either on an element or past the
+// end of the list, a position that sits between the back and the front. Any
+// number of cursors can move over, insert into and delete from the same list;
+// deleting the element a cursor is on does not invalidate it, and stepping from
+// a deleted element moves to its nearest surviving neighbour.
+type Cursor[T any] struct {
+	// list is the list the cursor moves over.
+	list *List[T]
+
+	// elem is the current element. &list.root when past the end.
+	elem *Element[T]
+}
+
+// Cursor returns a new cursor on the front of the list, or past the end if
+// the list is empty.
+//
+// Returns:
+//   - *Cursor[T]: The new cursor. Nil if the receiver is nil.
+func (l *List[T]) Cursor() *Cursor[T] {
+	if l == nil {
+		return nil
+	}
+
+	l.lazyInit()
+
+	return &Cursor[T]{
+		list: l,
+		elem: l.root.next,
+	}
+}
+
+// CursorBack returns a new cursor on the back of the list, or past the end if
+// the list is empty.
+//
+// Returns:
+//   - *Cursor[T]: The new cursor. Nil if the receiver is nil.
+func (l *List[T]) CursorBack() *Cursor[T] {
+	if l == nil {
+		return nil
+	}
+
+	l.lazyInit()
+
+	return &Cursor[T]{
+		list: l,
+		elem: l.root.prev,
+	}
+}
+
+// isLive checks whether e is the sentinel or an element of the cursor's list.
+func (c *Cursor[T]) isLive(e *Element[T]) bool {
+	return e == &c.list.root || e.list == c.list
+}
+
+// settle returns the first element, starting at e and following the given
+// direction, that is either the sentinel or an element of the cursor's list.
+// Elements that were spliced into another list lead to the sentinel.
+func (c *Cursor[T]) settle(e *Element[T], forward bool) *Element[T] {
+	for !c.isLive(e) {
+		if e.list != nil {
+			return &c.list.root
+		}
+
+		if forward {
+			e = e.next
+		} else {
+			e = e.prev
+		}
+	}
+
+	return e
+}
+
+// Element returns the element the cursor is on.
+//
+// Returns:
+//   - *Element[T]: The element. Nil if the cursor is past the end, on a deleted
+//     element, or the receiver is nil.
+func (c *Cursor[T]) Element() *Element[T] {
+	if c == nil || c.elem == &c.list.root || c.elem.list != c.list {
+		return nil
+	}
+
+	return c.elem
+}
+
+// Value returns the value of the element the cursor is on.
+//
+// Returns:
+//   - T: The value.
+//   - error: An error if the cursor is not on an element of the list.
+//
+// Errors:
+//   - ErrInvalidHandle: If the cursor is past the end or on a deleted element.
+func (c *Cursor[T]) Value() (T, error) {
+	e := c.Element()
+	if e == nil {
+		return *new(T), ErrInvalidHandle
+	}
+
+	return e.value, nil
+}
+
+// Next moves the cursor to the next element. Moving past the back of the list
+// puts the cursor past the end; moving from past the end wraps to the front.
+//
+// Returns:
+//   - bool: True if the cursor ended up on an element, false otherwise.
+func (c *Cursor[T]) Next() bool {
+	if c == nil {
+		return false
+	}
+
+	c.elem = c.settle(c.elem.next, true)
+
+	return c.elem != &c.list.root
+}
+
+// Prev moves the cursor to the previous element. Moving before the front of the
+// list puts the cursor past the end; moving from past the end wraps to the back.
+//
+// Returns:
+//   - bool: True if the cursor ended up on an element, false otherwise.
+func (c *Cursor[T]) Prev() bool {
+	if c == nil {
+		return false
+	}
+
+	c.elem = c.settle(c.elem.prev, false)
+
+	return c.elem != &c.list.root
+}
+
+// InsertBefore inserts a new element right before the cursor. If the cursor is
+// past the end, the element is inserted at the back of the list. The cursor
+// does not move.
+//
+// Parameters:
+//   - value: The value of the new element.
+//
+// Returns:
+//   - *Element[T]: The new element. Nil if an error occurred.
+//   - error: An error if the element could not be inserted.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If the cursor is on a deleted element.
+func (c *Cursor[T]) InsertBefore(value T) (*Element[T], error) {
+	if c == nil {
+		return nil, common.ErrNilReceiver
+	} else if !c.isLive(c.elem) {
+		return nil, ErrInvalidHandle
+	}
+
+	return c.list.insertAfter(value, c.elem.prev), nil
+}
+
+// InsertAfter inserts a new element right after the cursor. If the cursor is
+// past the end, the element is inserted at the front of the list. The cursor
+// does not move.
+//
+// Parameters:
+//   - value: The value of the new element.
+//
+// Returns:
+//   - *Element[T]: The new element. Nil if an error occurred.
+//   - error: An error if the element could not be inserted.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If the cursor is on a deleted element.
+func (c *Cursor[T]) InsertAfter(value T) (*Element[T], error) {
+	if c == nil {
+		return nil, common.ErrNilReceiver
+	} else if !c.isLive(c.elem) {
+		return nil, ErrInvalidHandle
+	}
+
+	return c.list.insertAfter(value, c.elem), nil
+}
+
+// Delete removes the element the cursor is on and moves the cursor to the next
+// element, or past the end if it was the back of the list.
+//
+// Returns:
+//   - T: The value of the removed element.
+//   - error: An error if the element could not be removed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If the cursor is past the end or on a deleted element.
+func (c *Cursor[T]) Delete() (T, error) {
+	if c == nil {
+		return *new(T), common.ErrNilReceiver
+	}
+
+	e := c.Element()
+	if e == nil {
+		return *new(T), ErrInvalidHandle
+	}
+
+	value, _ := c.list.Remove(e)
+	_ = c.Next()
+
+	return value, nil
+}
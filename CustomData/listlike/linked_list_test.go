@@ -0,0 +1,89 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestList tests the element-based operations of the list.
+func TestList(t *testing.T) {
+	l := NewList([]int{1, 2, 3})
+
+	two := l.Front().Next()
+
+	_, _ = l.InsertBefore(10, two)
+	_, _ = l.InsertAfter(20, two)
+	_ = l.MoveToFront(l.Back())
+
+	other := NewList([]int{7, 8})
+	_ = l.SpliceAfter(two, other)
+
+	want := []int{3, 1, 10, 2, 7, 8, 20}
+
+	got := slices.Collect(l.All())
+	if !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	if l.Size() != len(want) || !other.IsEmpty() {
+		t.Errorf("want sizes %d and 0, got %d and %d", len(want), l.Size(), other.Size())
+	}
+
+	_, err := other.Remove(two)
+	if err != ErrInvalidHandle {
+		t.Errorf("want %v, got %v", ErrInvalidHandle, err)
+	}
+}
+
+// TestCursor tests that cursors survive deletions made by other cursors.
+func TestCursor(t *testing.T) {
+	l := NewList([]int{1, 2, 3, 4})
+
+	c1 := l.Cursor()
+	c2 := l.Cursor()
+
+	_ = c1.Next() // 2
+	_ = c2.Next() // 2
+
+	elem, err := c2.Delete() // c2 on 3
+	if err != nil || elem != 2 {
+		t.Fatalf("want 2, got %d (%v)", elem, err)
+	}
+
+	_, err = c1.Value()
+	if err != ErrInvalidHandle {
+		t.Errorf("want %v, got %v", ErrInvalidHandle, err)
+	}
+
+	_ = c1.Next()
+
+	v, _ := c1.Value()
+	if v != 3 {
+		t.Errorf("want 3, got %d", v)
+	}
+
+	_, _ = c2.InsertAfter(5)
+	_ = c2.Next()
+	_ = c2.Next()
+	_ = c2.Next() // past the end
+
+	if c2.Element() != nil {
+		t.Errorf("want cursor past the end")
+	}
+
+	_, _ = c2.InsertBefore(6)
+
+	want := []int{1, 3, 5, 4, 6}
+
+	got := slices.Collect(l.All())
+	if !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	slices.Reverse(want)
+
+	got = slices.Collect(l.Backward())
+	if !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
@@ -18,6 +18,95 @@ type Lister interface {
 	Reset()
 }
 
+// Stacker is an interface implemented by LIFO list-like data structures.
+type Stacker[T any] interface {
+	Lister
+
+	// Push adds an element to the top of the stack.
+	//
+	// Parameters:
+	//   - elem: The element to add.
+	//
+	// Returns:
+	//   - error: An error if the element could not be added.
+	Push(elem T) error
+
+	// PushMany adds multiple elements to the stack such that the first element
+	// ends up at the top. It must be equal to calling Push on each element from
+	// the last to the first.
+	//
+	// Parameters:
+	//   - elems: The elements to add.
+	//
+	// Returns:
+	//   - error: An error if the elements could not be added.
+	PushMany(elems []T) error
+
+	// Pop removes the element at the top of the stack.
+	//
+	// Returns:
+	//   - T: The element that was removed.
+	//   - error: An error if the element could not be removed.
+	//
+	// Errors:
+	//   - ErrEmptyStack: If the stack is empty.
+	Pop() (T, error)
+
+	// Peek returns the element at the top of the stack.
+	//
+	// Returns:
+	//   - T: The element at the top of the stack.
+	//   - error: An error if the stack is empty.
+	//
+	// Errors:
+	//   - ErrEmptyStack: If the stack is empty.
+	Peek() (T, error)
+}
+
+// Queuer is an interface implemented by FIFO list-like data structures.
+type Queuer[T any] interface {
+	Lister
+
+	// Enqueue adds an element to the end of the queue.
+	//
+	// Parameters:
+	//   - elem: The element to add.
+	//
+	// Returns:
+	//   - error: An error if the element could not be added.
+	Enqueue(elem T) error
+
+	// EnqueueMany adds multiple elements to the end of the queue. It must be
+	// equal to calling Enqueue on each element in order.
+	//
+	// Parameters:
+	//   - elems: The elements to add.
+	//
+	// Returns:
+	//   - error: An error if the elements could not be added.
+	EnqueueMany(elems []T) error
+
+	// Dequeue removes the element at the start of the queue.
+	//
+	// Returns:
+	//   - T: The element that was removed.
+	//   - error: An error if the element could not be removed.
+	//
+	// Errors:
+	//   - ErrEmptyQueue: If the queue is empty.
+	Dequeue() (T, error)
+
+	// First returns the element at the start of the queue.
+	//
+	// Returns:
+	//   - T: The element at the start of the queue.
+	//   - error: An error if the queue is empty.
+	//
+	// Errors:
+	//   - ErrEmptyQueue: If the queue is empty.
+	First() (T, error)
+}
+
 // baseList is the base implementation of Lister.
 type baseList[T any] struct {
 	// slice is the underlying list-like data structure.
@@ -0,0 +1,269 @@
+package listlike
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// encodeGob encodes the given value with encoding/gob.
+//
+// Parameters:
+//   - v: The value to encode.
+//
+// Returns:
+//   - []byte: The encoded value.
+//   - error: An error if the value could not be encoded.
+func encodeGob(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decodeGob decodes the given data with encoding/gob.
+//
+// Parameters:
+//   - data: The data to decode.
+//   - v: The value to decode into.
+//
+// Returns:
+//   - error: An error if the data could not be decoded.
+func decodeGob(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The stack is encoded as an array of its elements in the order they would be
+// popped, so that the result can be passed to NewStack.
+func (s ArrayStack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *ArrayStack[T]) UnmarshalJSON(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := json.Unmarshal(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.fromSlice(elems)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s ArrayStack[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(s.toSlice())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *ArrayStack[T]) UnmarshalBinary(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := decodeGob(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.fromSlice(elems)
+
+	return nil
+}
+
+// toSlice returns the elements of the stack in the order they would be popped.
+func (s ArrayStack[T]) toSlice() []T {
+	elems := slices.Clone(s.slice)
+	slices.Reverse(elems)
+
+	if elems == nil {
+		elems = []T{}
+	}
+
+	return elems
+}
+
+// fromSlice replaces the elements of the stack with the given elements, the
+// first of which will be at the top of the stack.
+func (s *ArrayStack[T]) fromSlice(elems []T) {
+	s.Reset()
+
+	if len(elems) == 0 {
+		return
+	}
+
+	slices.Reverse(elems)
+	s.slice = elems
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The queue is encoded as an array of its elements in the order they would be
+// dequeued, so that the result can be passed to NewQueue.
+func (q Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.toSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	if q == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := json.Unmarshal(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	q.Reset()
+	_ = q.EnqueueMany(elems)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (q Queue[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(q.toSlice())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	if q == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := decodeGob(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	q.Reset()
+	_ = q.EnqueueMany(elems)
+
+	return nil
+}
+
+// toSlice returns the elements of the queue in the order they would be dequeued.
+func (q Queue[T]) toSlice() []T {
+	elems := make([]T, 0, q.size)
+	return slices.AppendSeq(elems, q.All())
+}
+
+// refusableStackData is the serialized form of a RefusableStack.
+type refusableStackData[T any] struct {
+	// Elems are the elements of the stack from the bottom to the top, followed
+	// by the popped elements from the least to the most recently popped.
+	Elems []T `json:"elems"`
+
+	// Top is the number of elements that were not popped.
+	Top int `json:"top"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The stack is encoded as an object holding its underlying elements and the
+// position of its top so that the popped elements can still be accepted or
+// refused after decoding. Active savepoints are not encoded.
+func (s RefusableStack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toData())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *RefusableStack[T]) UnmarshalJSON(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var d refusableStackData[T]
+
+	err := json.Unmarshal(data, &d)
+	if err != nil {
+		return err
+	}
+
+	return s.fromData(d)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// Active savepoints are not encoded.
+func (s RefusableStack[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(s.toData())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *RefusableStack[T]) UnmarshalBinary(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var d refusableStackData[T]
+
+	err := decodeGob(data, &d)
+	if err != nil {
+		return err
+	}
+
+	return s.fromData(d)
+}
+
+// toData returns the serialized form of the stack.
+func (s RefusableStack[T]) toData() refusableStackData[T] {
+	elems := slices.Clone(s.slice)
+	if elems == nil {
+		elems = []T{}
+	}
+
+	return refusableStackData[T]{
+		Elems: elems,
+		Top:   s.top,
+	}
+}
+
+// fromData replaces the state of the stack with the given serialized form.
+//
+// Returns:
+//   - error: An error if the serialized form is not a valid stack.
+func (s *RefusableStack[T]) fromData(d refusableStackData[T]) error {
+	tmp := RefusableStack[T]{
+		slice: d.Elems,
+		top:   d.Top,
+	}
+
+	err := tmp.Validate()
+	if err != nil {
+		return err
+	}
+
+	s.Reset()
+
+	if len(d.Elems) > 0 {
+		s.slice = d.Elems
+	}
+
+	s.top = d.Top
+
+	return nil
+}
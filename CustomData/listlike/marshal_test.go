@@ -0,0 +1,92 @@
+package listlike
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+// TestRefusableStack_Marshal tests that a refusable stack keeps its popped
+// elements through JSON and binary round-trips.
+func TestRefusableStack_Marshal(t *testing.T) {
+	s := NewRefusableStack([]int{1, 2, 3})
+	_, _ = s.Pop()
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	var fromJSON RefusableStack[int]
+
+	err = json.Unmarshal(data, &fromJSON)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	data, err = s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	var fromBinary RefusableStack[int]
+
+	err = fromBinary.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	for _, got := range []*RefusableStack[int]{&fromJSON, &fromBinary} {
+		if !slices.Equal(got.Popped(), []int{1}) {
+			t.Errorf("want %v, got %v", []int{1}, got.Popped())
+		}
+
+		got.Refuse()
+
+		if !slices.Equal(slices.Collect(got.All()), []int{1, 2, 3}) {
+			t.Errorf("want %v, got %v", []int{1, 2, 3}, slices.Collect(got.All()))
+		}
+	}
+
+	err = json.Unmarshal([]byte(`{"elems":[1],"top":2}`), &fromJSON)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+}
+
+// TestQueue_Marshal tests JSON and binary round-trips of queues and stacks.
+func TestQueue_Marshal(t *testing.T) {
+	q := NewQueue([]int{1, 2, 3})
+	_, _ = q.Dequeue()
+
+	data, _ := json.Marshal(q)
+	if string(data) != "[2,3]" {
+		t.Errorf("want %q, got %q", "[2,3]", string(data))
+	}
+
+	data, _ = q.MarshalBinary()
+
+	var other Queue[int]
+
+	_ = other.UnmarshalBinary(data)
+
+	if !slices.Equal(slices.Collect(other.All()), []int{2, 3}) {
+		t.Errorf("want %v, got %v", []int{2, 3}, slices.Collect(other.All()))
+	}
+
+	s := NewStack([]int{1, 2, 3})
+
+	data, _ = json.Marshal(s)
+	if string(data) != "[1,2,3]" {
+		t.Errorf("want %q, got %q", "[1,2,3]", string(data))
+	}
+
+	var stack ArrayStack[int]
+
+	_ = json.Unmarshal(data, &stack)
+
+	top, _ := stack.Peek()
+	if top != 1 {
+		t.Errorf("want 1, got %d", top)
+	}
+}
@@ -0,0 +1,260 @@
+package listlike
+
+// pnode is a node of a persistent singly linked list.
+type pnode[T any] struct {
+	// elem is the element of the node.
+	elem T
+
+	// next is the next node.
+	next *pnode[T]
+}
+
+// PStack is a persistent (immutable) stack. Push and Pop never modify the
+// receiver; instead they return a new version that shares its structure with
+// the old one, so taking a snapshot is just copying the value. The zero value
+// is an empty stack.
+type PStack[T any] struct {
+	// top is the top node of the stack.
+	top *pnode[T]
+
+	// size is the number of elements in the stack.
+	size int
+}
+
+// Size returns the number of elements in the stack.
+//
+// Returns:
+//   - int: The number of elements in the stack. Never negative.
+func (s PStack[T]) Size() int {
+	return s.size
+}
+
+// IsEmpty checks whether the stack is empty.
+//
+// Returns:
+//   - bool: True if the stack is empty, false otherwise.
+func (s PStack[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// NewPStack creates a new persistent stack from a slice. The first element of
+// the slice will be at the top of the stack.
+//
+// Parameters:
+//   - elems: The elements to add to the stack.
+//
+// Returns:
+//   - PStack[T]: The new stack.
+func NewPStack[T any](elems []T) PStack[T] {
+	var s PStack[T]
+
+	for i := len(elems) - 1; i >= 0; i-- {
+		s = s.Push(elems[i])
+	}
+
+	return s
+}
+
+// Push returns a new version of the stack with the element on top. The
+// receiver is not modified.
+//
+// Parameters:
+//   - elem: The element to push.
+//
+// Returns:
+//   - PStack[T]: The new version of the stack.
+func (s PStack[T]) Push(elem T) PStack[T] {
+	return PStack[T]{
+		top: &pnode[T]{
+			elem: elem,
+			next: s.top,
+		},
+		size: s.size + 1,
+	}
+}
+
+// Pop returns the element at the top of the stack together with a new version
+// of the stack without it. The receiver is not modified.
+//
+// Returns:
+//   - T: The element that was popped.
+//   - PStack[T]: The new version of the stack.
+//   - error: An error if the stack is empty.
+//
+// Errors:
+//   - ErrEmptyStack: If the stack is empty.
+func (s PStack[T]) Pop() (T, PStack[T], error) {
+	if s.top == nil {
+		return *new(T), s, ErrEmptyStack
+	}
+
+	return s.top.elem, PStack[T]{
+		top:  s.top.next,
+		size: s.size - 1,
+	}, nil
+}
+
+// Peek returns the element at the top of the stack.
+//
+// Returns:
+//   - T: The element at the top of the stack.
+//   - error: An error if the stack is empty.
+//
+// Errors:
+//   - ErrEmptyStack: If the stack is empty.
+func (s PStack[T]) Peek() (T, error) {
+	if s.top == nil {
+		return *new(T), ErrEmptyStack
+	}
+
+	return s.top.elem, nil
+}
+
+// reversed returns a new list with the nodes of the given list in reverse order.
+//
+// Parameters:
+//   - node: The head of the list.
+//
+// Returns:
+//   - *pnode[T]: The head of the reversed list.
+func reversed[T any](node *pnode[T]) *pnode[T] {
+	var head *pnode[T]
+
+	for ; node != nil; node = node.next {
+		head = &pnode[T]{
+			elem: node.elem,
+			next: head,
+		}
+	}
+
+	return head
+}
+
+// PQueue is a persistent (immutable) queue implemented as a pair of persistent
+// lists. Enqueue and Dequeue never modify the receiver; instead they return a
+// new version that shares its structure with the old one, so taking a snapshot
+// is just copying the value. Dequeue runs in amortized O(1) time when versions
+// are used linearly. The zero value is an empty queue.
+type PQueue[T any] struct {
+	// front holds the first elements of the queue in dequeue order.
+	front *pnode[T]
+
+	// back holds the last elements of the queue in reverse order.
+	back *pnode[T]
+
+	// size is the number of elements in the queue.
+	size int
+}
+
+// Size returns the number of elements in the queue.
+//
+// Returns:
+//   - int: The number of elements in the queue. Never negative.
+func (q PQueue[T]) Size() int {
+	return q.size
+}
+
+// IsEmpty checks whether the queue is empty.
+//
+// Returns:
+//   - bool: True if the queue is empty, false otherwise.
+func (q PQueue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// NewPQueue creates a new persistent queue from a slice. The first element of
+// the slice will be at the start of the queue.
+//
+// Parameters:
+//   - elems: The elements to add to the queue.
+//
+// Returns:
+//   - PQueue[T]: The new queue.
+func NewPQueue[T any](elems []T) PQueue[T] {
+	var front *pnode[T]
+
+	for i := len(elems) - 1; i >= 0; i-- {
+		front = &pnode[T]{
+			elem: elems[i],
+			next: front,
+		}
+	}
+
+	return PQueue[T]{
+		front: front,
+		size:  len(elems),
+	}
+}
+
+// Enqueue returns a new version of the queue with the element at its end. The
+// receiver is not modified.
+//
+// Parameters:
+//   - elem: The element to enqueue.
+//
+// Returns:
+//   - PQueue[T]: The new version of the queue.
+func (q PQueue[T]) Enqueue(elem T) PQueue[T] {
+	if q.front == nil {
+		return PQueue[T]{
+			front: &pnode[T]{
+				elem: elem,
+			},
+			size: 1,
+		}
+	}
+
+	return PQueue[T]{
+		front: q.front,
+		back: &pnode[T]{
+			elem: elem,
+			next: q.back,
+		},
+		size: q.size + 1,
+	}
+}
+
+// Dequeue returns the element at the start of the queue together with a new
+// version of the queue without it. The receiver is not modified.
+//
+// Returns:
+//   - T: The element that was dequeued.
+//   - PQueue[T]: The new version of the queue.
+//   - error: An error if the queue is empty.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (q PQueue[T]) Dequeue() (T, PQueue[T], error) {
+	if q.front == nil {
+		return *new(T), q, ErrEmptyQueue
+	}
+
+	next := PQueue[T]{
+		front: q.front.next,
+		back:  q.back,
+		size:  q.size - 1,
+	}
+
+	if next.front == nil {
+		next.front = reversed(next.back)
+		next.back = nil
+	}
+
+	return q.front.elem, next, nil
+}
+
+// First returns the element at the start of the queue.
+//
+// Returns:
+//   - T: The element at the start of the queue.
+//   - error: An error if the queue is empty.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (q PQueue[T]) First() (T, error) {
+	if q.front == nil {
+		return *new(T), ErrEmptyQueue
+	}
+
+	return q.front.elem, nil
+}
@@ -0,0 +1,150 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestPStack tests that older versions of a persistent stack are not affected
+// by later operations.
+func TestPStack(t *testing.T) {
+	s1 := NewPStack([]int{1, 2})
+	s2 := s1.Push(0)
+
+	elem, s3, err := s2.Pop()
+	if err != nil || elem != 0 {
+		t.Fatalf("want 0, got %d (%v)", elem, err)
+	}
+
+	if s1.Size() != 2 || s2.Size() != 3 || s3.Size() != 2 {
+		t.Errorf("want sizes 2, 3, 2, got %d, %d, %d", s1.Size(), s2.Size(), s3.Size())
+	}
+
+	top, _ := s1.Peek()
+	if top != 1 {
+		t.Errorf("want 1, got %d", top)
+	}
+
+	var empty PStack[int]
+
+	_, _, err = empty.Pop()
+	if err != ErrEmptyStack {
+		t.Errorf("want %v, got %v", ErrEmptyStack, err)
+	}
+}
+
+// TestPQueue tests that older versions of a persistent queue are not affected
+// by later operations.
+func TestPQueue(t *testing.T) {
+	q1 := NewPQueue([]int{1, 2})
+	q2 := q1.Enqueue(3).Enqueue(4)
+
+	var got []int
+
+	for q := q2; !q.IsEmpty(); {
+		var elem int
+
+		elem, q, _ = q.Dequeue()
+		got = append(got, elem)
+	}
+
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("want %v, got %v", []int{1, 2, 3, 4}, got)
+	}
+
+	if q1.Size() != 2 || q2.Size() != 4 {
+		t.Errorf("want sizes 2 and 4, got %d and %d", q1.Size(), q2.Size())
+	}
+
+	first, _ := q2.First()
+	if first != 1 {
+		t.Errorf("want 1, got %d", first)
+	}
+}
+
+// pstackSink keeps the compiler from optimizing away the benchmarked pushes.
+var pstackSink PStack[int]
+
+// BenchmarkPStack_Snapshot benchmarks taking a snapshot of a large persistent
+// stack and pushing onto it.
+func BenchmarkPStack_Snapshot(b *testing.B) {
+	var s PStack[int]
+
+	for i := 0; i < 10000; i++ {
+		s = s.Push(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		snapshot := s
+		pstackSink = snapshot.Push(i)
+	}
+}
+
+// BenchmarkArrayStack_Snapshot benchmarks taking a snapshot of a large
+// ArrayStack by copying it and pushing onto it.
+func BenchmarkArrayStack_Snapshot(b *testing.B) {
+	var s ArrayStack[int]
+
+	for i := 0; i < 10000; i++ {
+		_ = s.Push(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		snapshot := ArrayStack[int]{
+			slice: slices.Clone(s.slice),
+		}
+
+		_ = snapshot.Push(i)
+	}
+}
+
+// pqueueSink keeps the compiler from optimizing away the benchmarked enqueues.
+var pqueueSink PQueue[int]
+
+// BenchmarkPQueue_Snapshot benchmarks taking a snapshot of a large persistent
+// queue and enqueuing onto it.
+func BenchmarkPQueue_Snapshot(b *testing.B) {
+	var q PQueue[int]
+
+	for i := 0; i < 10000; i++ {
+		q = q.Enqueue(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		snapshot := q
+		pqueueSink = snapshot.Enqueue(i)
+	}
+}
+
+// BenchmarkQueue_Snapshot benchmarks taking a snapshot of a large Queue by
+// copying it and enqueuing onto it.
+func BenchmarkQueue_Snapshot(b *testing.B) {
+	var q Queue[int]
+
+	for i := 0; i < 10000; i++ {
+		_ = q.Enqueue(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		snapshot := Queue[int]{
+			buf:      slices.Clone(q.buf),
+			head:     q.head,
+			size:     q.size,
+			reserved: q.reserved,
+		}
+
+		_ = snapshot.Enqueue(i)
+	}
+}
@@ -0,0 +1,320 @@
+package listlike
+
+import (
+	"cmp"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Handle is a reference to an element of a priority queue. It stays valid
+// until the element is popped or removed from the queue.
+type Handle[T any] struct {
+	// elem is the element the handle refers to.
+	elem T
+
+	// idx is the position of the element in the heap. -1 if the element
+	// is no longer in the queue.
+	idx int
+
+	// owner is the queue that holds the element.
+	owner any
+}
+
+// Value returns the element the handle refers to.
+//
+// Returns:
+//   - T: The element. The zero value if the receiver is nil.
+func (h *Handle[T]) Value() T {
+	if h == nil {
+		return *new(T)
+	}
+
+	return h.elem
+}
+
+// IsValid checks whether the handle still refers to an element in a queue.
+//
+// Returns:
+//   - bool: True if the handle is valid, false otherwise.
+func (h *Handle[T]) IsValid() bool {
+	return h != nil && h.idx >= 0
+}
+
+// PriorityQueue is a priority queue implemented as a binary heap. The element
+// for which the comparison function reports the smallest value is popped first.
+type PriorityQueue[T any] struct {
+	// heap is the binary heap.
+	heap []*Handle[T]
+
+	// compare is the comparison function.
+	compare func(a, b T) int
+}
+
+// Size implements the Lister interface.
+func (pq PriorityQueue[T]) Size() int {
+	return len(pq.heap)
+}
+
+// IsEmpty implements the Lister interface.
+func (pq PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.heap) == 0
+}
+
+// Reset implements the Lister interface.
+//
+// Any handle obtained before the reset becomes invalid.
+func (pq *PriorityQueue[T]) Reset() {
+	if pq == nil {
+		return
+	}
+
+	for _, h := range pq.heap {
+		h.idx = -1
+		h.owner = nil
+	}
+
+	if len(pq.heap) > 0 {
+		clear(pq.heap)
+		pq.heap = nil
+	}
+}
+
+// NewPriorityQueue creates a new priority queue that orders its elements with
+// the given comparison function. The comparison function must return a negative
+// number when a has a higher priority than b, a positive number when b has a
+// higher priority than a, and zero otherwise.
+//
+// Parameters:
+//   - compare: The comparison function.
+//
+// Returns:
+//   - *PriorityQueue[T]: The new priority queue. Nil if an error occurred.
+//   - error: An error if the queue could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If compare is nil.
+func NewPriorityQueue[T any](compare func(a, b T) int) (*PriorityQueue[T], error) {
+	if compare == nil {
+		return nil, common.NewErrNilParam("compare")
+	}
+
+	return &PriorityQueue[T]{
+		compare: compare,
+	}, nil
+}
+
+// NewOrderedPriorityQueue creates a new priority queue where smaller elements
+// have a higher priority.
+//
+// Returns:
+//   - *PriorityQueue[T]: The new priority queue. Never returns nil.
+func NewOrderedPriorityQueue[T cmp.Ordered]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		compare: cmp.Compare[T],
+	}
+}
+
+// less checks whether the element at i has a higher priority than the element at j.
+func (pq PriorityQueue[T]) less(i, j int) bool {
+	return pq.compare(pq.heap[i].elem, pq.heap[j].elem) < 0
+}
+
+// swap swaps the elements at i and j.
+func (pq PriorityQueue[T]) swap(i, j int) {
+	pq.heap[i], pq.heap[j] = pq.heap[j], pq.heap[i]
+	pq.heap[i].idx = i
+	pq.heap[j].idx = j
+}
+
+// up moves the element at i towards the root until the heap property holds.
+func (pq PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			break
+		}
+
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at i towards the leaves until the heap property
+// holds.
+//
+// Returns:
+//   - bool: True if the element was moved, false otherwise.
+func (pq PriorityQueue[T]) down(i int) bool {
+	start := i
+	n := len(pq.heap)
+
+	for {
+		left := 2*i + 1
+		if left >= n {
+			break
+		}
+
+		child := left
+		if right := left + 1; right < n && pq.less(right, left) {
+			child = right
+		}
+
+		if !pq.less(child, i) {
+			break
+		}
+
+		pq.swap(i, child)
+		i = child
+	}
+
+	return i > start
+}
+
+// fix restores the heap property after the element at i was changed.
+func (pq PriorityQueue[T]) fix(i int) {
+	if !pq.down(i) {
+		pq.up(i)
+	}
+}
+
+// removeAt removes the element at i from the heap and invalidates its handle.
+func (pq *PriorityQueue[T]) removeAt(i int) *Handle[T] {
+	last := len(pq.heap) - 1
+
+	if i != last {
+		pq.swap(i, last)
+	}
+
+	h := pq.heap[last]
+	pq.heap[last] = nil
+	pq.heap = pq.heap[:last]
+
+	if i != last {
+		pq.fix(i)
+	}
+
+	h.idx = -1
+	h.owner = nil
+
+	return h
+}
+
+// Push adds an element to the priority queue.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - *Handle[T]: The handle of the element. Nil if an error occurred.
+//   - error: An error if the element could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the queue has no comparison function.
+func (pq *PriorityQueue[T]) Push(elem T) (*Handle[T], error) {
+	if pq == nil {
+		return nil, common.ErrNilReceiver
+	} else if pq.compare == nil {
+		return nil, common.NewErrNilParam("compare")
+	}
+
+	h := &Handle[T]{
+		elem:  elem,
+		idx:   len(pq.heap),
+		owner: pq,
+	}
+
+	pq.heap = append(pq.heap, h)
+	pq.up(h.idx)
+
+	return h, nil
+}
+
+// Pop removes the element with the highest priority from the queue.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed from the queue.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if pq == nil || len(pq.heap) == 0 {
+		return *new(T), ErrEmptyQueue
+	}
+
+	h := pq.removeAt(0)
+
+	return h.elem, nil
+}
+
+// Peek returns the element with the highest priority.
+//
+// Returns:
+//   - T: The element with the highest priority.
+//   - error: An error if the queue is empty.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (pq PriorityQueue[T]) Peek() (T, error) {
+	if len(pq.heap) == 0 {
+		return *new(T), ErrEmptyQueue
+	}
+
+	return pq.heap[0].elem, nil
+}
+
+// owns checks whether the handle refers to an element of this queue.
+func (pq *PriorityQueue[T]) owns(h *Handle[T]) bool {
+	return h != nil && h.idx >= 0 && h.owner == any(pq)
+}
+
+// Update replaces the element referred to by the handle and restores the
+// priority order.
+//
+// Parameters:
+//   - h: The handle of the element to update.
+//   - elem: The new element.
+//
+// Returns:
+//   - error: An error if the element could not be updated.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If the handle does not refer to an element of this queue.
+func (pq *PriorityQueue[T]) Update(h *Handle[T], elem T) error {
+	if pq == nil {
+		return common.ErrNilReceiver
+	} else if !pq.owns(h) {
+		return ErrInvalidHandle
+	}
+
+	h.elem = elem
+	pq.fix(h.idx)
+
+	return nil
+}
+
+// Remove removes the element referred to by the handle from the queue.
+//
+// Parameters:
+//   - h: The handle of the element to remove.
+//
+// Returns:
+//   - T: The element that was removed.
+//   - error: An error if the element could not be removed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidHandle: If the handle does not refer to an element of this queue.
+func (pq *PriorityQueue[T]) Remove(h *Handle[T]) (T, error) {
+	if pq == nil {
+		return *new(T), common.ErrNilReceiver
+	} else if !pq.owns(h) {
+		return *new(T), ErrInvalidHandle
+	}
+
+	h = pq.removeAt(h.idx)
+
+	return h.elem, nil
+}
@@ -0,0 +1,51 @@
+package listlike
+
+import (
+	"testing"
+)
+
+// TestPriorityQueue tests that elements are popped in priority order and that
+// handles can update and remove elements.
+func TestPriorityQueue(t *testing.T) {
+	pq := NewOrderedPriorityQueue[int]()
+
+	var handles []*Handle[int]
+
+	for _, elem := range []int{5, 3, 8, 1, 9, 2} {
+		h, err := pq.Push(elem)
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		handles = append(handles, h)
+	}
+
+	_ = pq.Update(handles[2], 0) // 8 -> 0
+
+	elem, _ := pq.Remove(handles[4]) // 9
+	if elem != 9 {
+		t.Errorf("want 9, got %d", elem)
+	}
+
+	err := pq.Update(handles[4], 4)
+	if err != ErrInvalidHandle {
+		t.Errorf("want %v, got %v", ErrInvalidHandle, err)
+	}
+
+	want := []int{0, 1, 2, 3, 5}
+
+	for _, w := range want {
+		elem, err := pq.Pop()
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		if elem != w {
+			t.Errorf("want %d, got %d", w, elem)
+		}
+	}
+
+	if !pq.IsEmpty() {
+		t.Errorf("want empty queue, got %d elements", pq.Size())
+	}
+}
@@ -1,24 +1,46 @@
 package listlike
 
 import (
+	"iter"
+	"slices"
+
 	"github.com/PlayerR9/mysd-lib/common"
 )
 
-// Queue is a simple implementation of a queue. An empty queue can either be
-// created with the `var queue Queue[T]` syntax or with the `new(Queue[T])`
+const (
+	// minQueueCap is the smallest capacity a non-empty queue buffer will have.
+	minQueueCap int = 8
+)
+
+// Queue is a simple implementation of a queue backed by a growable circular
+// buffer. An empty queue can either be created with the `var queue Queue[T]`
+// syntax or with the `new(Queue[T])` constructor.
+//
+// Both Enqueue and Dequeue run in amortized O(1) time. The buffer doubles when
+// full and halves when its occupancy drops to a quarter, so long-running
+// producer/consumer loops do not retain memory indefinitely.
 type Queue[T any] struct {
-	// slice is the internal slice.
-	slice []T
+	// buf is the circular buffer.
+	buf []T
+
+	// head is the index of the first element in buf.
+	head int
+
+	// size is the number of elements in the queue.
+	size int
+
+	// reserved is the minimum capacity below which the buffer never shrinks.
+	reserved int
 }
 
 // Size implements the Lister interface.
 func (q Queue[T]) Size() int {
-	return len(q.slice)
+	return q.size
 }
 
 // IsEmpty implements the Lister interface.
 func (q Queue[T]) IsEmpty() bool {
-	return len(q.slice) == 0
+	return q.size == 0
 }
 
 // Reset implements the Lister interface.
@@ -27,10 +49,22 @@ func (q *Queue[T]) Reset() {
 		return
 	}
 
-	if len(q.slice) > 0 {
-		clear(q.slice)
-		q.slice = nil
+	if len(q.buf) > 0 {
+		clear(q.buf)
+		q.buf = nil
 	}
+
+	q.head = 0
+	q.size = 0
+	q.reserved = 0
+}
+
+// Cap returns the capacity of the underlying buffer.
+//
+// Returns:
+//   - int: The capacity of the underlying buffer. Never negative.
+func (q Queue[T]) Cap() int {
+	return len(q.buf)
 }
 
 // NewQueue creates a new queue from a slice.
@@ -45,9 +79,91 @@ func NewQueue[T any](elems []T) *Queue[T] {
 		return &Queue[T]{}
 	}
 
+	buf := make([]T, len(elems))
+	copy(buf, elems)
+
 	return &Queue[T]{
-		slice: elems,
+		buf:  buf,
+		size: len(elems),
+	}
+}
+
+// resize moves the elements of the queue into a new buffer of the given
+// capacity. The capacity must be at least q.size.
+//
+// Parameters:
+//   - capacity: The capacity of the new buffer.
+func (q *Queue[T]) resize(capacity int) {
+	var buf []T
+
+	if capacity > 0 {
+		buf = make([]T, capacity)
+
+		if q.size > 0 {
+			n := copy(buf, q.buf[q.head:min(q.head+q.size, len(q.buf))])
+			copy(buf[n:], q.buf[:q.size-n])
+		}
+	}
+
+	clear(q.buf)
+
+	q.buf = buf
+	q.head = 0
+}
+
+// grow makes sure the buffer can hold n more elements.
+//
+// Parameters:
+//   - n: The number of elements to make room for.
+func (q *Queue[T]) grow(n int) {
+	needed := q.size + n
+	if needed <= len(q.buf) {
+		return
+	}
+
+	capacity := max(len(q.buf), minQueueCap)
+
+	for capacity < needed {
+		capacity *= 2
+	}
+
+	q.resize(capacity)
+}
+
+// shrink halves the buffer when the queue occupies a quarter of it or less.
+func (q *Queue[T]) shrink() {
+	half := len(q.buf) / 2
+
+	if half < minQueueCap || half < q.reserved || q.size > len(q.buf)/4 {
+		return
+	}
+
+	q.resize(half)
+}
+
+// Reserve makes sure the queue can hold at least n elements without
+// reallocating. The buffer will also never shrink below n elements until
+// the queue is reset. Does nothing if n is not positive.
+//
+// Parameters:
+//   - n: The number of elements to reserve space for.
+//
+// Returns:
+//   - error: An error if the receiver is nil.
+func (q *Queue[T]) Reserve(n int) error {
+	if q == nil {
+		return common.ErrNilReceiver
+	} else if n <= 0 {
+		return nil
+	}
+
+	q.reserved = n
+
+	if n > len(q.buf) {
+		q.resize(n)
 	}
+
+	return nil
 }
 
 // Enqueue adds an element to the queue.
@@ -62,7 +178,10 @@ func (q *Queue[T]) Enqueue(elem T) error {
 		return common.ErrNilReceiver
 	}
 
-	q.slice = append(q.slice, elem)
+	q.grow(1)
+
+	q.buf[(q.head+q.size)%len(q.buf)] = elem
+	q.size++
 
 	return nil
 }
@@ -82,7 +201,14 @@ func (q *Queue[T]) EnqueueMany(elems []T) error {
 		return common.ErrNilReceiver
 	}
 
-	q.slice = append(q.slice, elems...)
+	q.grow(len(elems))
+
+	tail := (q.head + q.size) % len(q.buf)
+
+	n := copy(q.buf[tail:], elems)
+	copy(q.buf, elems[n:])
+
+	q.size += len(elems)
 
 	return nil
 }
@@ -96,17 +222,27 @@ func (q *Queue[T]) EnqueueMany(elems []T) error {
 // Errors:
 //   - ErrEmptyQueue: If the queue is empty.
 func (q *Queue[T]) Dequeue() (T, error) {
-	if q == nil || len(q.slice) == 0 {
+	if q == nil || q.size == 0 {
 		return *new(T), ErrEmptyQueue
 	}
 
-	elem := q.slice[0]
-	q.slice = q.slice[1:]
+	elem := q.buf[q.head]
+	q.buf[q.head] = *new(T)
+
+	q.head = (q.head + 1) % len(q.buf)
+	q.size--
+
+	if q.size == 0 {
+		q.head = 0
+	}
+
+	q.shrink()
 
 	return elem, nil
 }
 
-// First returns the element at the start of the queue.
+// First returns the element at the start of the queue; that is, the
+// element that the next call to Dequeue would return.
 //
 // Returns:
 //   - T: The element at the start of the queue.
@@ -115,9 +251,95 @@ func (q *Queue[T]) Dequeue() (T, error) {
 // Errors:
 //   - ErrEmptyQueue: If the queue is empty.
 func (q Queue[T]) First() (T, error) {
-	if len(q.slice) == 0 {
+	if q.size == 0 {
 		return *new(T), ErrEmptyQueue
 	}
 
-	return q.slice[len(q.slice)-1], nil
+	return q.buf[q.head], nil
+}
+
+// Last returns the element at the end of the queue; that is, the element
+// that was most recently enqueued.
+//
+// Returns:
+//   - T: The element at the end of the queue.
+//   - error: An error if the queue is empty.
+//
+// Errors:
+//   - ErrEmptyQueue: If the queue is empty.
+func (q Queue[T]) Last() (T, error) {
+	if q.size == 0 {
+		return *new(T), ErrEmptyQueue
+	}
+
+	return q.buf[(q.head+q.size-1)%len(q.buf)], nil
+}
+
+// QueueFromSeq creates a new queue from a sequence. The first element of the
+// sequence will be at the start of the queue so that QueueFromSeq(q.All())
+// returns a copy of q.
+//
+// Parameters:
+//   - seq: The sequence of elements to add to the queue.
+//
+// Returns:
+//   - *Queue[T]: The new queue. Never returns nil.
+func QueueFromSeq[T any](seq iter.Seq[T]) *Queue[T] {
+	if seq == nil {
+		return &Queue[T]{}
+	}
+
+	return NewQueue(slices.Collect(seq))
+}
+
+// All returns an iterator over the elements of the queue in the order they
+// would be dequeued.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the queue. Never returns nil.
+func (q Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.buf[(q.head+i)%len(q.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the queue in the reverse
+// order they would be dequeued.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the queue. Never returns nil.
+func (q Queue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := q.size - 1; i >= 0; i-- {
+			if !yield(q.buf[(q.head+i)%len(q.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that dequeues the elements of the queue as it
+// yields them. If the iteration stops early, the remaining elements stay in
+// the queue.
+//
+// Returns:
+//   - iter.Seq[T]: The dequeued elements. Never returns nil.
+func (q *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if q == nil {
+			return
+		}
+
+		for q.size > 0 {
+			elem, _ := q.Dequeue()
+
+			if !yield(elem) {
+				return
+			}
+		}
+	}
 }
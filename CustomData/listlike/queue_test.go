@@ -0,0 +1,169 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestQueue_FIFO tests that the queue dequeues elements in insertion order
+// across buffer wrap-arounds.
+func TestQueue_FIFO(t *testing.T) {
+	var q Queue[int]
+
+	var next int
+
+	for i := 0; i < 100; i++ {
+		_ = q.Enqueue(i)
+
+		if i%3 == 0 {
+			elem, err := q.Dequeue()
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+
+			if elem != next {
+				t.Fatalf("want %d, got %d", next, elem)
+			}
+
+			next++
+		}
+	}
+
+	for !q.IsEmpty() {
+		elem, _ := q.Dequeue()
+		if elem != next {
+			t.Fatalf("want %d, got %d", next, elem)
+		}
+
+		next++
+	}
+
+	if next != 100 {
+		t.Errorf("want 100, got %d", next)
+	}
+
+	_, err := q.Dequeue()
+	if err != ErrEmptyQueue {
+		t.Errorf("want %v, got %v", ErrEmptyQueue, err)
+	}
+}
+
+// TestQueue_FirstLast tests the First and Last methods.
+func TestQueue_FirstLast(t *testing.T) {
+	q := NewQueue([]int{1, 2})
+	_ = q.EnqueueMany([]int{3, 4})
+
+	first, err := q.First()
+	if err != nil || first != 1 {
+		t.Errorf("want 1, got %d (%v)", first, err)
+	}
+
+	last, err := q.Last()
+	if err != nil || last != 4 {
+		t.Errorf("want 4, got %d (%v)", last, err)
+	}
+}
+
+// TestQueue_Shrink tests that the buffer shrinks after the queue drains.
+func TestQueue_Shrink(t *testing.T) {
+	var q Queue[int]
+
+	for i := 0; i < 1024; i++ {
+		_ = q.Enqueue(i)
+	}
+
+	for i := 0; i < 1020; i++ {
+		_, _ = q.Dequeue()
+	}
+
+	if q.Cap() > 4*minQueueCap {
+		t.Errorf("want capacity at most %d, got %d", 4*minQueueCap, q.Cap())
+	}
+
+	_ = q.Reserve(256)
+
+	for i := 0; i < 4; i++ {
+		_, _ = q.Dequeue()
+	}
+
+	if q.Cap() < 256 {
+		t.Errorf("want capacity at least 256, got %d", q.Cap())
+	}
+}
+
+// sliceQueue is the reslicing queue that Queue used to be; kept for benchmarks.
+type sliceQueue[T any] struct {
+	slice []T
+}
+
+func (q *sliceQueue[T]) Enqueue(elem T) {
+	q.slice = append(q.slice, elem)
+}
+
+func (q *sliceQueue[T]) Dequeue() T {
+	elem := q.slice[0]
+	q.slice = q.slice[1:]
+
+	return elem
+}
+
+// BenchmarkQueue benchmarks a steady producer/consumer loop on Queue.
+func BenchmarkQueue(b *testing.B) {
+	var q Queue[int]
+
+	for i := 0; i < 64; i++ {
+		_ = q.Enqueue(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = q.Enqueue(i)
+		_, _ = q.Dequeue()
+	}
+}
+
+// BenchmarkSliceQueue benchmarks a steady producer/consumer loop on the
+// previous reslicing implementation.
+func BenchmarkSliceQueue(b *testing.B) {
+	var q sliceQueue[int]
+
+	for i := 0; i < 64; i++ {
+		q.Enqueue(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+		_ = q.Dequeue()
+	}
+}
+
+// TestQueue_Iter tests the iteration methods of the queue.
+func TestQueue_Iter(t *testing.T) {
+	q := NewQueue([]int{1, 2, 3})
+
+	clone := QueueFromSeq(q.All())
+
+	var got []int
+
+	for elem := range clone.Drain() {
+		got = append(got, elem)
+	}
+
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("want %v, got %v", []int{1, 2, 3}, got)
+	}
+
+	if !clone.IsEmpty() || q.Size() != 3 {
+		t.Errorf("want drained clone and untouched queue")
+	}
+
+	got = slices.Collect(q.Backward())
+	if !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("want %v, got %v", []int{3, 2, 1}, got)
+	}
+}
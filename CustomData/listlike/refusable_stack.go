@@ -2,6 +2,7 @@ package listlike
 
 import (
 	"fmt"
+	"iter"
 	"slices"
 
 	"github.com/PlayerR9/mysd-lib/common"
@@ -16,6 +17,43 @@ type RefusableStack[T any] struct {
 
 	// top is the top of the stack.
 	top int
+
+	// savepoints are the active savepoints, from the outermost to the innermost.
+	savepoints []savepoint
+
+	// journal records how to undo every change made while a savepoint is active.
+	journal []journalEntry[T]
+
+	// lastID is the identifier of the most recently created savepoint.
+	lastID int
+}
+
+// Savepoint is a token that identifies a state of a RefusableStack. The zero
+// value is never a valid savepoint.
+type Savepoint struct {
+	// id is the identifier of the savepoint.
+	id int
+}
+
+// savepoint is an active savepoint of a RefusableStack.
+type savepoint struct {
+	// id is the identifier of the savepoint.
+	id int
+
+	// pos is the length of the journal when the savepoint was created.
+	pos int
+}
+
+// journalEntry is the state of a RefusableStack before a change.
+type journalEntry[T any] struct {
+	// top is the previous top of the stack.
+	top int
+
+	// length is the previous length of the slice.
+	length int
+
+	// tail are the elements that were removed from the end of the slice, if any.
+	tail []T
 }
 
 // Validate implements the assert.Validater interface.
@@ -53,6 +91,8 @@ func (s *RefusableStack[T]) Reset() {
 	}
 
 	s.top = 0
+
+	s.dropSavepoints(0)
 }
 
 // NewStack creates a new stack from a slice.
@@ -83,7 +123,11 @@ func NewRefusableStack[T any](elems []T) *RefusableStack[T] {
 //   - elem: The element to add.
 //
 // Returns:
-//   - error: An error if the receiver is nil.
+//   - error: An error if the element could not be pushed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrCannotPush: If some elements were popped and no savepoint is active.
 func (s *RefusableStack[T]) Push(elem T) error {
 	if s == nil {
 		return common.ErrNilReceiver
@@ -91,10 +135,13 @@ func (s *RefusableStack[T]) Push(elem T) error {
 
 	// common.Validate(s)
 
-	if s.top != len(s.slice) {
-		return ErrCannotPush
+	err := s.preparePush()
+	if err != nil {
+		return err
 	}
 
+	s.record(nil)
+
 	s.slice = append(s.slice, elem)
 	s.top++
 
@@ -108,7 +155,11 @@ func (s *RefusableStack[T]) Push(elem T) error {
 //   - elems: The elements to add.
 //
 // Returns:
-//   - error: An error if the receiver is nil.
+//   - error: An error if the elements could not be pushed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrCannotPush: If some elements were popped and no savepoint is active.
 //
 // WARNING: As a side-effect, the original list will be reversed.
 func (s *RefusableStack[T]) PushMany(elems []T) error {
@@ -120,10 +171,13 @@ func (s *RefusableStack[T]) PushMany(elems []T) error {
 
 	// common.Validate(s)
 
-	if s.top != len(s.slice) {
-		return ErrCannotPush
+	err := s.preparePush()
+	if err != nil {
+		return err
 	}
 
+	s.record(nil)
+
 	slices.Reverse(elems)
 
 	s.slice = append(s.slice, elems...)
@@ -151,6 +205,8 @@ func (s *RefusableStack[T]) Pop() (T, error) {
 		return *new(T), ErrEmptyStack
 	}
 
+	s.record(nil)
+
 	s.top--
 
 	return s.slice[s.top], nil
@@ -181,7 +237,7 @@ func (s *RefusableStack[T]) Accept() {
 	// common.Validate(s)
 
 	if s.top != len(s.slice) {
-		s.slice = s.slice[:s.top:s.top]
+		s.accept()
 	}
 }
 
@@ -194,7 +250,11 @@ func (s *RefusableStack[T]) Refuse() {
 
 	// common.Validate(s)
 
-	s.top = len(s.slice)
+	if s.top != len(s.slice) {
+		s.record(nil)
+
+		s.top = len(s.slice)
+	}
 }
 
 // RefuseOne refuses the last popped element. Does nothing if no element was popped.
@@ -206,6 +266,8 @@ func (s *RefusableStack[T]) RefuseOne() {
 	// common.Validate(s)
 
 	if s.top != len(s.slice) {
+		s.record(nil)
+
 		s.top++
 	}
 }
@@ -227,3 +289,255 @@ func (s RefusableStack[T]) Popped() []T {
 
 	return slice
 }
+
+// record saves the current state of the stack in the journal so that it can be
+// restored by RollbackTo. Does nothing if no savepoint is active.
+//
+// Parameters:
+//   - tail: The elements about to be removed from the end of the slice, if any.
+func (s *RefusableStack[T]) record(tail []T) {
+	if len(s.savepoints) == 0 {
+		return
+	}
+
+	var saved []T
+
+	if len(tail) > 0 {
+		saved = make([]T, len(tail))
+		copy(saved, tail)
+	}
+
+	s.journal = append(s.journal, journalEntry[T]{
+		top:    s.top,
+		length: len(s.slice),
+		tail:   saved,
+	})
+}
+
+// accept discards the popped elements for good.
+func (s *RefusableStack[T]) accept() {
+	s.record(s.slice[s.top:])
+
+	clear(s.slice[s.top:])
+	s.slice = s.slice[:s.top:s.top]
+}
+
+// preparePush makes sure that elements can be pushed onto the stack. If some
+// elements were popped and a savepoint is active, the pops are accepted.
+//
+// Returns:
+//   - error: An error if elements cannot be pushed.
+//
+// Errors:
+//   - ErrCannotPush: If some elements were popped and no savepoint is active.
+func (s *RefusableStack[T]) preparePush() error {
+	if s.top == len(s.slice) {
+		return nil
+	} else if len(s.savepoints) == 0 {
+		return ErrCannotPush
+	}
+
+	s.accept()
+
+	return nil
+}
+
+// findSavepoint returns the position of the savepoint in the list of active savepoints.
+//
+// Parameters:
+//   - sp: The savepoint to find.
+//
+// Returns:
+//   - int: The position of the savepoint. -1 if it is not active.
+func (s RefusableStack[T]) findSavepoint(sp Savepoint) int {
+	for i := len(s.savepoints) - 1; i >= 0; i-- {
+		if s.savepoints[i].id == sp.id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// dropSavepoints deactivates the savepoint at the given position and every
+// savepoint nested in it. The journal is discarded once no savepoint remains.
+//
+// Parameters:
+//   - idx: The position of the outermost savepoint to drop.
+func (s *RefusableStack[T]) dropSavepoints(idx int) {
+	s.savepoints = s.savepoints[:idx]
+
+	if idx == 0 {
+		clear(s.journal)
+		s.journal = nil
+		s.savepoints = nil
+	}
+}
+
+// Mark creates a savepoint at the current state of the stack. Savepoints can be
+// nested; while at least one is active, elements can be pushed even if some
+// elements were popped and not accepted nor refused, in which case the pops are
+// accepted.
+//
+// Returns:
+//   - Savepoint: The new savepoint. The zero value if the receiver is nil.
+func (s *RefusableStack[T]) Mark() Savepoint {
+	if s == nil {
+		return Savepoint{}
+	}
+
+	s.lastID++
+
+	s.savepoints = append(s.savepoints, savepoint{
+		id:  s.lastID,
+		pos: len(s.journal),
+	})
+
+	return Savepoint{
+		id: s.lastID,
+	}
+}
+
+// RollbackTo restores the stack to the state it had when the savepoint was
+// created; that is, pushed elements are removed and popped or accepted elements
+// are restored. The savepoint and every savepoint created after it are no longer
+// valid.
+//
+// Parameters:
+//   - sp: The savepoint to roll back to.
+//
+// Returns:
+//   - error: An error if the stack could not be rolled back.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidSavepoint: If the savepoint is not active.
+func (s *RefusableStack[T]) RollbackTo(sp Savepoint) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	idx := s.findSavepoint(sp)
+	if idx == -1 {
+		return ErrInvalidSavepoint
+	}
+
+	pos := s.savepoints[idx].pos
+
+	for i := len(s.journal) - 1; i >= pos; i-- {
+		entry := s.journal[i]
+
+		n := entry.length - len(entry.tail)
+
+		clear(s.slice[n:])
+		s.slice = append(s.slice[:n], entry.tail...)
+		s.top = entry.top
+
+		s.journal[i] = journalEntry[T]{}
+	}
+
+	s.journal = s.journal[:pos]
+
+	s.dropSavepoints(idx)
+
+	return nil
+}
+
+// Commit keeps every change made since the savepoint was created. The savepoint
+// and every savepoint created after it are no longer valid; the changes can still
+// be rolled back by an enclosing savepoint.
+//
+// Parameters:
+//   - sp: The savepoint to commit.
+//
+// Returns:
+//   - error: An error if the savepoint could not be committed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - ErrInvalidSavepoint: If the savepoint is not active.
+func (s *RefusableStack[T]) Commit(sp Savepoint) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	idx := s.findSavepoint(sp)
+	if idx == -1 {
+		return ErrInvalidSavepoint
+	}
+
+	s.dropSavepoints(idx)
+
+	return nil
+}
+
+// RefusableStackFromSeq creates a new stack from a sequence. The first element
+// of the sequence will be at the top of the stack so that
+// RefusableStackFromSeq(s.All()) returns a copy of s without its popped elements.
+//
+// Parameters:
+//   - seq: The sequence of elements to add to the stack.
+//
+// Returns:
+//   - *RefusableStack[T]: The new stack. Never returns nil.
+func RefusableStackFromSeq[T any](seq iter.Seq[T]) *RefusableStack[T] {
+	if seq == nil {
+		return &RefusableStack[T]{}
+	}
+
+	return NewRefusableStack(slices.Collect(seq))
+}
+
+// All returns an iterator over the elements of the stack in the order they
+// would be popped; that is, from the top to the bottom. Popped elements are
+// not included.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the stack. Never returns nil.
+func (s RefusableStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.top - 1; i >= 0; i-- {
+			if !yield(s.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the stack in the reverse
+// order they would be popped; that is, from the bottom to the top. Popped
+// elements are not included.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the stack. Never returns nil.
+func (s RefusableStack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range s.slice[:s.top] {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops the elements of the stack as it yields
+// them. If the iteration stops early, the remaining elements stay in the stack.
+// As with Pop, the drained elements can still be refused.
+//
+// Returns:
+//   - iter.Seq[T]: The popped elements. Never returns nil.
+func (s *RefusableStack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil {
+			return
+		}
+
+		for s.top > 0 {
+			elem, _ := s.Pop()
+
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
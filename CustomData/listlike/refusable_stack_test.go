@@ -0,0 +1,121 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestRefusableStack_Savepoints tests nested savepoints.
+func TestRefusableStack_Savepoints(t *testing.T) {
+	s := NewRefusableStack([]int{1, 2, 3}) // top is 1
+
+	outer := s.Mark()
+
+	_, _ = s.Pop() // 1
+
+	err := s.Push(10)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	inner := s.Mark()
+
+	_, _ = s.Pop() // 10
+	_, _ = s.Pop() // 2
+	_ = s.Push(20)
+
+	top, _ := s.Peek()
+	if top != 20 {
+		t.Errorf("want 20, got %d", top)
+	}
+
+	err = s.RollbackTo(inner)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	top, _ = s.Peek()
+	if top != 10 || s.Size() != 3 {
+		t.Errorf("want top 10 and size 3, got %d and %d", top, s.Size())
+	}
+
+	err = s.Commit(inner)
+	if err != ErrInvalidSavepoint {
+		t.Errorf("want %v, got %v", ErrInvalidSavepoint, err)
+	}
+
+	_ = s.RollbackTo(outer)
+
+	var got []int
+
+	for !s.IsEmpty() {
+		elem, _ := s.Pop()
+		got = append(got, elem)
+	}
+
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("want %v, got %v", []int{1, 2, 3}, got)
+	}
+
+	s.Refuse()
+
+	err = s.Push(4)
+	if err != nil {
+		t.Errorf("want no error, got %v", err)
+	}
+
+	_, _ = s.Pop()
+
+	err = s.Push(4)
+	if err != ErrCannotPush {
+		t.Errorf("want %v, got %v", ErrCannotPush, err)
+	}
+}
+
+// TestRefusableStack_Iter tests the iteration methods of the stack.
+func TestRefusableStack_Iter(t *testing.T) {
+	s := NewRefusableStack([]int{1, 2, 3}) // top is 1
+
+	_, _ = s.Pop()
+
+	got := slices.Collect(s.All())
+	if !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("want %v, got %v", []int{2, 3}, got)
+	}
+
+	got = slices.Collect(s.Backward())
+	if !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("want %v, got %v", []int{3, 2}, got)
+	}
+
+	clone := RefusableStackFromSeq(s.All())
+	if clone.Size() != 2 || len(clone.Popped()) != 0 {
+		t.Errorf("want a copy without popped elements, got %v", slices.Collect(clone.All()))
+	}
+
+	for elem := range clone.Drain() {
+		if elem == 2 {
+			break
+		}
+	}
+
+	if top, _ := clone.Peek(); clone.Size() != 1 || top != 3 {
+		t.Errorf("want [3] left after an early break, got %v", slices.Collect(clone.All()))
+	}
+
+	got = slices.Collect(s.Drain())
+	if !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("want %v, got %v", []int{2, 3}, got)
+	}
+
+	if !s.IsEmpty() {
+		t.Errorf("want drained stack, got %v", slices.Collect(s.All()))
+	}
+
+	s.Refuse()
+
+	got = slices.Collect(s.All())
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("want the drained elements back, got %v", got)
+	}
+}
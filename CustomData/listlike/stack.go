@@ -1,6 +1,7 @@
 package listlike
 
 import (
+	"iter"
 	"slices"
 
 	"github.com/PlayerR9/mysd-lib/common"
@@ -14,16 +15,6 @@ func init() {
 	Stack = stackT{}
 }
 
-type Stacker interface {
-	Size() int
-	IsEmpty() bool
-	Reset()
-	Push(elem any)
-	PushMany(elems []any)
-	Pop() (any, error)
-	Peek() (any, error)
-}
-
 func (stackT) New(elems ...any) []any {
 	if len(elems) == 0 {
 		return nil
@@ -201,3 +192,71 @@ func (s ArrayStack[T]) Peek() (T, error) {
 
 	return s.slice[len(s.slice)-1], nil
 }
+
+// StackFromSeq creates a new stack from a sequence. The first element of the
+// sequence will be at the top of the stack so that StackFromSeq(s.All())
+// returns a copy of s.
+//
+// Parameters:
+//   - seq: The sequence of elements to add to the stack.
+//
+// Returns:
+//   - *ArrayStack[T]: The new stack. Never returns nil.
+func StackFromSeq[T any](seq iter.Seq[T]) *ArrayStack[T] {
+	if seq == nil {
+		return &ArrayStack[T]{}
+	}
+
+	return NewStack(slices.Collect(seq))
+}
+
+// All returns an iterator over the elements of the stack in the order they
+// would be popped; that is, from the top to the bottom.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the stack. Never returns nil.
+func (s ArrayStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.slice) - 1; i >= 0; i-- {
+			if !yield(s.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the stack in the reverse
+// order they would be popped; that is, from the bottom to the top.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the stack. Never returns nil.
+func (s ArrayStack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range s.slice {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops the elements of the stack as it yields
+// them. If the iteration stops early, the remaining elements stay in the stack.
+//
+// Returns:
+//   - iter.Seq[T]: The popped elements. Never returns nil.
+func (s *ArrayStack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil {
+			return
+		}
+
+		for len(s.slice) > 0 {
+			elem, _ := s.Pop()
+
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
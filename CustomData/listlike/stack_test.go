@@ -0,0 +1,46 @@
+package listlike
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestStack_Iter tests the iteration methods of the stack.
+func TestStack_Iter(t *testing.T) {
+	s := NewStack([]int{1, 2, 3}) // top is 1
+
+	got := slices.Collect(s.All())
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("want %v, got %v", []int{1, 2, 3}, got)
+	}
+
+	got = slices.Collect(s.Backward())
+	if !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("want %v, got %v", []int{3, 2, 1}, got)
+	}
+
+	clone := StackFromSeq(s.All())
+
+	for elem := range clone.Drain() {
+		if elem == 2 {
+			break
+		}
+	}
+
+	if top, _ := clone.Peek(); clone.Size() != 1 || top != 3 {
+		t.Errorf("want [3] left after an early break, got %v", slices.Collect(clone.All()))
+	}
+
+	got = slices.Collect(s.Drain())
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("want %v, got %v", []int{1, 2, 3}, got)
+	}
+
+	if !s.IsEmpty() {
+		t.Errorf("want drained stack, got %v", slices.Collect(s.All()))
+	}
+
+	if empty := StackFromSeq[int](nil); !empty.IsEmpty() {
+		t.Errorf("want empty stack, got %v", slices.Collect(empty.All()))
+	}
+}
@@ -0,0 +1,568 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"reflect"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// algebra is implemented by the sets of this package to provide faster set
+// operations than cloneAlgebra. Every method returns a new set of the same
+// concrete kind as the receiver and never modifies its operands. other is never
+// nil.
+type algebra[T any] interface {
+	// union returns the elements of the receiver or of other. It fails if an
+	// element of other cannot be added to a set of the receiver's kind.
+	union(other Set[T]) (Set[T], error)
+
+	// intersection returns the elements of the receiver that are in other.
+	intersection(other Set[T]) Set[T]
+
+	// difference returns the elements of the receiver that are not in other.
+	difference(other Set[T]) Set[T]
+
+	// symmetricDifference returns the elements that are in exactly one of the
+	// receiver and other. It fails if an element of other cannot be added to a
+	// set of the receiver's kind.
+	symmetricDifference(other Set[T]) (Set[T], error)
+}
+
+// subsetChecker is implemented by sets that can check inclusion faster than by
+// looking up every element.
+type subsetChecker[T any] interface {
+	// subsetOf checks whether every element of the receiver is in other.
+	//
+	// Returns:
+	//   - bool: True if the receiver is a subset of other.
+	//   - bool: False if the fast path does not apply to other.
+	subsetOf(other Set[T]) (bool, bool)
+
+	// disjointFrom checks whether the receiver and other have no element in common.
+	//
+	// Returns:
+	//   - bool: True if the sets are disjoint.
+	//   - bool: False if the fast path does not apply to other.
+	disjointFrom(other Set[T]) (bool, bool)
+}
+
+// cloneAlgebra implements the algebra interface for any set by cloning it and
+// then adding or removing elements.
+type cloneAlgebra[T any] struct {
+	// set is the receiver of the operations.
+	set Set[T]
+}
+
+// union implements the algebra interface.
+func (a cloneAlgebra[T]) union(other Set[T]) (Set[T], error) {
+	res := a.set.Clone()
+
+	err := Merge(res, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// intersection implements the algebra interface.
+func (a cloneAlgebra[T]) intersection(other Set[T]) Set[T] {
+	res := a.set.Clone()
+
+	res.RemoveIf(func(elem T) bool {
+		return !other.Contains(elem)
+	})
+
+	return res
+}
+
+// difference implements the algebra interface.
+func (a cloneAlgebra[T]) difference(other Set[T]) Set[T] {
+	res := a.set.Clone()
+	res.RemoveIf(other.Contains)
+
+	return res
+}
+
+// symmetricDifference implements the algebra interface.
+func (a cloneAlgebra[T]) symmetricDifference(other Set[T]) (Set[T], error) {
+	res := a.set.Clone()
+
+	for elem := range other.Elem() {
+		if a.set.Contains(elem) {
+			res.Remove(elem)
+			continue
+		}
+
+		err := res.Add(elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// emptySet is a read-only empty set used in place of nil operands.
+type emptySet[T any] struct{}
+
+// Size implements the Set interface.
+func (emptySet[T]) Size() int {
+	return 0
+}
+
+// IsEmpty implements the Set interface.
+func (emptySet[T]) IsEmpty() bool {
+	return true
+}
+
+// Reset implements the Set interface.
+func (emptySet[T]) Reset() {}
+
+// Add implements the Set interface.
+//
+// The empty set is read-only; thus, Add always fails.
+func (emptySet[T]) Add(elem T) error {
+	return common.ErrNilReceiver
+}
+
+// AddMany implements the Set interface.
+//
+// The empty set is read-only; thus, AddMany fails unless elems is empty.
+func (emptySet[T]) AddMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	}
+
+	return common.ErrNilReceiver
+}
+
+// Contains implements the Set interface.
+func (emptySet[T]) Contains(elem T) bool {
+	return false
+}
+
+// Elem implements the Set interface.
+func (emptySet[T]) Elem() iter.Seq[T] {
+	return func(yield func(T) bool) {}
+}
+
+// Remove implements the Set interface.
+func (emptySet[T]) Remove(elem T) bool {
+	return false
+}
+
+// RemoveMany implements the Set interface.
+func (emptySet[T]) RemoveMany(elems []T) int {
+	return 0
+}
+
+// Pop implements the Set interface.
+func (emptySet[T]) Pop() (T, error) {
+	return *new(T), ErrEmptySet
+}
+
+// RemoveIf implements the Set interface.
+func (emptySet[T]) RemoveIf(pred func(elem T) bool) int {
+	return 0
+}
+
+// Clone implements the Set interface.
+func (e emptySet[T]) Clone() Set[T] {
+	return e
+}
+
+// Clear implements the Set interface.
+func (emptySet[T]) Clear() {}
+
+// orEmpty returns the given set, or the empty set if it is nil or a nil pointer.
+//
+// Parameters:
+//   - s: The set.
+//
+// Returns:
+//   - Set[T]: The set. Never nil.
+func orEmpty[T any](s Set[T]) Set[T] {
+	if s == nil {
+		return emptySet[T]{}
+	}
+
+	if rv := reflect.ValueOf(s); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return emptySet[T]{}
+	}
+
+	return s
+}
+
+// prepare checks the operands of a set-producing operation.
+//
+// Parameters:
+//   - a: The first operand. Must not be nil nor a nil pointer.
+//   - b: The second operand. Nil and nil pointers are treated as the empty set.
+//
+// Returns:
+//   - algebra[T]: The first operand as an algebra.
+//   - Set[T]: The second operand. Never nil.
+//   - error: An error if the operands are not valid.
+func prepare[T any](a, b Set[T]) (algebra[T], Set[T], error) {
+	if _, ok := orEmpty(a).(emptySet[T]); ok {
+		return nil, nil, common.NewErrNilParam("a")
+	}
+
+	alg, ok := a.(algebra[T])
+	if !ok {
+		alg = cloneAlgebra[T]{set: a}
+	}
+
+	return alg, orEmpty(b), nil
+}
+
+// Union returns a new set with the elements that are in a or in b. The new set
+// is of the same concrete kind as a.
+//
+// Parameters:
+//   - a: The first set.
+//   - b: The second set. Nil and nil pointers are treated as the empty set.
+//
+// Returns:
+//   - Set[T]: The union of a and b. Nil if an error occurred.
+//   - error: An error if the union could not be computed.
+//
+// Errors:
+//   - common.ErrBadParam: If a is nil or a nil pointer.
+//   - any error returned by the Add method of a when an element of b cannot be
+//     held by a set of the kind of a.
+func Union[T any](a, b Set[T]) (Set[T], error) {
+	alg, b, err := prepare(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return alg.union(b)
+}
+
+// Intersection returns a new set with the elements that are both in a and in b.
+// The new set is of the same concrete kind as a.
+//
+// Parameters:
+//   - a: The first set.
+//   - b: The second set. Nil and nil pointers are treated as the empty set.
+//
+// Returns:
+//   - Set[T]: The intersection of a and b. Nil if an error occurred.
+//   - error: An error if the intersection could not be computed.
+//
+// Errors:
+//   - common.ErrBadParam: If a is nil or a nil pointer.
+func Intersection[T any](a, b Set[T]) (Set[T], error) {
+	alg, b, err := prepare(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return alg.intersection(b), nil
+}
+
+// Difference returns a new set with the elements of a that are not in b. The new
+// set is of the same concrete kind as a.
+//
+// Parameters:
+//   - a: The first set.
+//   - b: The second set. Nil and nil pointers are treated as the empty set.
+//
+// Returns:
+//   - Set[T]: The difference of a and b. Nil if an error occurred.
+//   - error: An error if the difference could not be computed.
+//
+// Errors:
+//   - common.ErrBadParam: If a is nil or a nil pointer.
+func Difference[T any](a, b Set[T]) (Set[T], error) {
+	alg, b, err := prepare(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return alg.difference(b), nil
+}
+
+// SymmetricDifference returns a new set with the elements that are in exactly
+// one of a and b. The new set is of the same concrete kind as a.
+//
+// Parameters:
+//   - a: The first set.
+//   - b: The second set. Nil and nil pointers are treated as the empty set.
+//
+// Returns:
+//   - Set[T]: The symmetric difference of a and b. Nil if an error occurred.
+//   - error: An error if the symmetric difference could not be computed.
+//
+// Errors:
+//   - common.ErrBadParam: If a is nil or a nil pointer.
+//   - any error returned by the Add method of a when an element of b cannot be
+//     held by a set of the kind of a.
+func SymmetricDifference[T any](a, b Set[T]) (Set[T], error) {
+	alg, b, err := prepare(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return alg.symmetricDifference(b)
+}
+
+// IsSubset checks whether every element of a is in b. Nil sets, including
+// nil pointers, are treated as empty sets.
+//
+// Parameters:
+//   - a: The candidate subset.
+//   - b: The candidate superset.
+//
+// Returns:
+//   - bool: True if a is a subset of b, false otherwise.
+func IsSubset[T any](a, b Set[T]) bool {
+	a, b = orEmpty(a), orEmpty(b)
+
+	if a.IsEmpty() {
+		return true
+	} else if a.Size() > b.Size() {
+		return false
+	}
+
+	if checker, ok := a.(subsetChecker[T]); ok {
+		if res, ok := checker.subsetOf(b); ok {
+			return res
+		}
+	}
+
+	for elem := range a.Elem() {
+		if !b.Contains(elem) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset checks whether every element of b is in a. Nil sets, including
+// nil pointers, are treated as empty sets.
+//
+// Parameters:
+//   - a: The candidate superset.
+//   - b: The candidate subset.
+//
+// Returns:
+//   - bool: True if a is a superset of b, false otherwise.
+func IsSuperset[T any](a, b Set[T]) bool {
+	return IsSubset(b, a)
+}
+
+// IsDisjoint checks whether a and b have no element in common. Nil sets,
+// including nil pointers, are treated as empty sets.
+//
+// Parameters:
+//   - a: The first set.
+//   - b: The second set.
+//
+// Returns:
+//   - bool: True if a and b are disjoint, false otherwise.
+func IsDisjoint[T any](a, b Set[T]) bool {
+	a, b = orEmpty(a), orEmpty(b)
+
+	if a.IsEmpty() || b.IsEmpty() {
+		return true
+	}
+
+	if checker, ok := a.(subsetChecker[T]); ok {
+		if res, ok := checker.disjointFrom(b); ok {
+			return res
+		}
+	}
+
+	if b.Size() < a.Size() {
+		a, b = b, a
+	}
+
+	for elem := range a.Elem() {
+		if b.Contains(elem) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal checks whether a and b have the same elements. Nil sets, including
+// nil pointers, are treated as empty sets.
+//
+// Parameters:
+//   - a: The first set.
+//   - b: The second set.
+//
+// Returns:
+//   - bool: True if a and b are equal, false otherwise.
+func Equal[T any](a, b Set[T]) bool {
+	a, b = orEmpty(a), orEmpty(b)
+
+	return a.Size() == b.Size() && IsSubset(a, b)
+}
+
+// union implements the algebra interface.
+func (s baseSet[T]) union(other Set[T]) (Set[T], error) {
+	res := s.clone(len(s.elems) + other.Size())
+
+	for elem := range other.Elem() {
+		res.elems[elem] = struct{}{}
+	}
+
+	return res, nil
+}
+
+// intersection implements the algebra interface.
+func (s baseSet[T]) intersection(other Set[T]) Set[T] {
+	res := &baseSet[T]{
+		elems: make(map[T]struct{}, min(len(s.elems), other.Size())),
+	}
+
+	if other.Size() < len(s.elems) {
+		for elem := range other.Elem() {
+			if _, ok := s.elems[elem]; ok {
+				res.elems[elem] = struct{}{}
+			}
+		}
+	} else {
+		for elem := range s.elems {
+			if other.Contains(elem) {
+				res.elems[elem] = struct{}{}
+			}
+		}
+	}
+
+	return res
+}
+
+// difference implements the algebra interface.
+func (s baseSet[T]) difference(other Set[T]) Set[T] {
+	if other.Size() < len(s.elems) {
+		res := s.clone(len(s.elems))
+
+		for elem := range other.Elem() {
+			delete(res.elems, elem)
+		}
+
+		return res
+	}
+
+	res := &baseSet[T]{
+		elems: make(map[T]struct{}),
+	}
+
+	for elem := range s.elems {
+		if !other.Contains(elem) {
+			res.elems[elem] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// symmetricDifference implements the algebra interface.
+func (s baseSet[T]) symmetricDifference(other Set[T]) (Set[T], error) {
+	res := s.clone(len(s.elems) + other.Size())
+
+	for elem := range other.Elem() {
+		if _, ok := s.elems[elem]; ok {
+			delete(res.elems, elem)
+		} else {
+			res.elems[elem] = struct{}{}
+		}
+	}
+
+	return res, nil
+}
+
+// clone returns a copy of the set.
+//
+// Parameters:
+//   - capacity: The capacity hint of the copy.
+//
+// Returns:
+//   - *baseSet[T]: The copy. Never returns nil.
+func (s baseSet[T]) clone(capacity int) *baseSet[T] {
+	res := &baseSet[T]{
+		elems: make(map[T]struct{}, capacity),
+	}
+
+	for elem := range s.elems {
+		res.elems[elem] = struct{}{}
+	}
+
+	return res
+}
+
+// sortedElems returns the elements of the given set in ascending order and
+// without duplicates. The returned slice must not be modified.
+//
+// Parameters:
+//   - other: The set.
+//
+// Returns:
+//   - []T: The sorted elements.
+func sortedElems[T cmp.Ordered](other Set[T]) []T {
+	if os, ok := other.(*OrderedSet[T]); ok && os != nil {
+		return os.elems
+	}
+
+	elems := slices.Collect(other.Elem())
+	slices.Sort(elems)
+
+	return slices.Compact(elems)
+}
+
+// union implements the algebra interface.
+func (s OrderedSet[T]) union(other Set[T]) (Set[T], error) {
+	return &OrderedSet[T]{
+		elems: merge(s.elems, sortedElems(other), cmp.Compare[T], true, true, true),
+	}, nil
+}
+
+// intersection implements the algebra interface.
+func (s OrderedSet[T]) intersection(other Set[T]) Set[T] {
+	return &OrderedSet[T]{
+		elems: merge(s.elems, sortedElems(other), cmp.Compare[T], false, true, false),
+	}
+}
+
+// difference implements the algebra interface.
+func (s OrderedSet[T]) difference(other Set[T]) Set[T] {
+	return &OrderedSet[T]{
+		elems: merge(s.elems, sortedElems(other), cmp.Compare[T], true, false, false),
+	}
+}
+
+// symmetricDifference implements the algebra interface.
+func (s OrderedSet[T]) symmetricDifference(other Set[T]) (Set[T], error) {
+	return &OrderedSet[T]{
+		elems: merge(s.elems, sortedElems(other), cmp.Compare[T], true, false, true),
+	}, nil
+}
+
+// subsetOf implements the subsetChecker interface.
+func (s OrderedSet[T]) subsetOf(other Set[T]) (bool, bool) {
+	os, ok := other.(*OrderedSet[T])
+	if !ok || os == nil {
+		return false, false
+	}
+
+	return len(merge(s.elems, os.elems, cmp.Compare[T], true, false, false)) == 0, true
+}
+
+// disjointFrom implements the subsetChecker interface.
+func (s OrderedSet[T]) disjointFrom(other Set[T]) (bool, bool) {
+	os, ok := other.(*OrderedSet[T])
+	if !ok || os == nil {
+		return false, false
+	}
+
+	return len(merge(s.elems, os.elems, cmp.Compare[T], false, true, false)) == 0, true
+}
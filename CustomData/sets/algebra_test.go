@@ -0,0 +1,124 @@
+package sets
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// TestAlgebra tests the set operations on both kinds of sets and on mixed operands.
+func TestAlgebra(t *testing.T) {
+	type Op func(a, b Set[int]) (Set[int], error)
+
+	tests := []struct {
+		name string
+		op   Op
+		want []int
+	}{
+		{"Union", Union[int], []int{1, 2, 3, 4, 5}},
+		{"Intersection", Intersection[int], []int{3}},
+		{"Difference", Difference[int], []int{1, 2}},
+		{"SymmetricDifference", SymmetricDifference[int], []int{1, 2, 4, 5}},
+	}
+
+	kinds := map[string]func(elems ...int) Set[int]{
+		"New": New[int],
+		"OrderedSet": func(elems ...int) Set[int] {
+			return NewOrderedSet(elems)
+		},
+		"OrderedFuncSet": func(elems ...int) Set[int] {
+			s, _ := NewOrderedFuncSet(cmp.Compare[int], elems)
+			return s
+		},
+	}
+
+	for _, tt := range tests {
+		for name_a, new_a := range kinds {
+			for name_b, new_b := range kinds {
+				t.Run(tt.name+"/"+name_a+"/"+name_b, func(t *testing.T) {
+					a := new_a(1, 2, 3)
+					b := new_b(3, 4, 5)
+
+					res, err := tt.op(a, b)
+					if err != nil {
+						t.Fatalf("want no error, got %v", err)
+					}
+
+					if fmt.Sprintf("%T", res) != fmt.Sprintf("%T", a) {
+						t.Errorf("want %T, got %T", a, res)
+					}
+
+					elems := slices.Sorted(res.Elem())
+					if !slices.Equal(elems, tt.want) {
+						t.Errorf("want %v, got %v", tt.want, elems)
+					}
+
+					if a.Size() != 3 || b.Size() != 3 {
+						t.Errorf("want operands unchanged, got sizes %d and %d", a.Size(), b.Size())
+					}
+				})
+			}
+		}
+	}
+
+	_, err := Union(nil, New(1))
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+}
+
+// TestPredicates tests IsSubset, IsSuperset, IsDisjoint and Equal.
+func TestPredicates(t *testing.T) {
+	small := NewOrderedSet([]int{1, 2})
+	big := NewOrderedSet([]int{1, 2, 3})
+	other := New(4, 5)
+
+	if !IsSubset[int](small, big) || IsSubset[int](big, small) {
+		t.Errorf("want small to be a strict subset of big")
+	}
+
+	if !IsSuperset[int](big, New(1, 3)) {
+		t.Errorf("want big to be a superset of {1, 3}")
+	}
+
+	if !IsDisjoint[int](big, other) || IsDisjoint[int](small, big) {
+		t.Errorf("want big and other to be disjoint, small and big not")
+	}
+
+	if !Equal[int](New(1, 2, 3), big) || Equal[int](small, big) {
+		t.Errorf("want {1, 2, 3} to equal big, small not")
+	}
+
+	if !Equal[int](nil, New[int]()) || !IsSubset[int](nil, small) {
+		t.Errorf("want nil to be the empty set")
+	}
+}
+
+// TestAlgebra_NilPointers tests that nil pointers are treated as empty sets.
+func TestAlgebra_NilPointers(t *testing.T) {
+	a := NewOrderedSet([]int{1, 2})
+
+	var nil_ordered *OrderedSet[int]
+
+	for _, b := range []Set[int]{nil_ordered, (*baseSet[int])(nil)} {
+		res, err := Union[int](a, b)
+		if err != nil || !Equal[int](res, a) {
+			t.Errorf("want %v, got %v (%v)", slices.Collect(a.Elem()), res, err)
+		}
+
+		res, err = Intersection[int](a, b)
+		if err != nil || !res.IsEmpty() {
+			t.Errorf("want an empty intersection, got %v (%v)", res, err)
+		}
+
+		if IsSubset[int](a, b) || !IsSubset[int](b, a) || !IsDisjoint[int](a, b) || !Equal[int](b, nil) {
+			t.Errorf("want %T(nil) to be treated as the empty set", b)
+		}
+	}
+
+	_, err := Union[int](nil_ordered, a)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+}
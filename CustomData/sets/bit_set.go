@@ -0,0 +1,481 @@
+package sets
+
+import (
+	"iter"
+	"math/bits"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// wordSize is the number of bits of a word of a BitSet.
+const wordSize uint = 64
+
+// MaxBitSetElem is the greatest element a BitSet can hold. A set that holds it
+// uses 512 MiB.
+const MaxBitSetElem uint = 1<<32 - 1
+
+// BitSet is a set of small non-negative integers stored as a bit array: the
+// memory it uses is proportional to its greatest element, not to its size. It
+// grows automatically. An empty bit set can either be created with the
+// `var set BitSet` syntax or with the `new(BitSet)` constructor.
+type BitSet struct {
+	// words are the bits of the set; elem is present if the bit elem%64 of
+	// words[elem/64] is set.
+	words []uint64
+
+	// count is the number of set bits.
+	count int
+}
+
+// NewBitSet creates a new bit set from the provided elements.
+//
+// Parameters:
+//   - elems: The elements to add to the set. Elements greater than
+//     MaxBitSetElem are ignored.
+//
+// Returns:
+//   - *BitSet: The new set. Never returns nil.
+func NewBitSet(elems ...uint) *BitSet {
+	s := new(BitSet)
+
+	for _, elem := range elems {
+		_ = s.Add(elem)
+	}
+
+	return s
+}
+
+// recount recomputes the number of set bits.
+func (s *BitSet) recount() {
+	s.count = 0
+
+	for _, w := range s.words {
+		s.count += bits.OnesCount64(w)
+	}
+}
+
+// grow makes sure that the word at the given index exists.
+func (s *BitSet) grow(idx int) {
+	if idx < len(s.words) {
+		return
+	}
+
+	if idx < cap(s.words) {
+		s.words = s.words[:idx+1]
+		return
+	}
+
+	words := make([]uint64, idx+1, max(2*cap(s.words), idx+1))
+	copy(words, s.words)
+
+	s.words = words
+}
+
+// Size implements the Set interface.
+func (s BitSet) Size() int {
+	return s.count
+}
+
+// IsEmpty implements the Set interface.
+func (s BitSet) IsEmpty() bool {
+	return s.count == 0
+}
+
+// Reset implements the Set interface.
+func (s *BitSet) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.words = nil
+	s.count = 0
+}
+
+// Add implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If elem is greater than MaxBitSetElem.
+func (s *BitSet) Add(elem uint) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if elem > MaxBitSetElem {
+		return common.NewErrBadParam("elem", "must not be greater than MaxBitSetElem")
+	}
+
+	idx := int(elem / wordSize)
+	mask := uint64(1) << (elem % wordSize)
+
+	s.grow(idx)
+
+	if s.words[idx]&mask == 0 {
+		s.words[idx] |= mask
+		s.count++
+	}
+
+	return nil
+}
+
+// AddMany implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If an element is greater than MaxBitSetElem. The
+//     elements before it are added.
+func (s *BitSet) AddMany(elems []uint) error {
+	if len(elems) == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	for _, elem := range elems {
+		err := s.Add(elem)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (s BitSet) Contains(elem uint) bool {
+	idx := elem / wordSize
+
+	return idx < uint(len(s.words)) && s.words[idx]&(uint64(1)<<(elem%wordSize)) != 0
+}
+
+// Elem implements the Set interface.
+//
+// The elements are iterated in ascending order.
+func (s BitSet) Elem() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for idx, w := range s.words {
+			for w != 0 {
+				bit := uint(bits.TrailingZeros64(w))
+
+				if !yield(uint(idx)*wordSize + bit) {
+					return
+				}
+
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// Remove implements the Set interface.
+func (s *BitSet) Remove(elem uint) bool {
+	if s == nil || !s.Contains(elem) {
+		return false
+	}
+
+	s.words[elem/wordSize] &^= uint64(1) << (elem % wordSize)
+	s.count--
+
+	return true
+}
+
+// RemoveMany implements the Set interface.
+func (s *BitSet) RemoveMany(elems []uint) int {
+	if s == nil {
+		return 0
+	}
+
+	var count int
+
+	for _, elem := range elems {
+		if s.Remove(elem) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is the smallest one.
+func (s *BitSet) Pop() (uint, error) {
+	if s == nil {
+		return 0, ErrEmptySet
+	}
+
+	elem, ok := s.NextSet(0)
+	if !ok {
+		return 0, ErrEmptySet
+	}
+
+	s.Remove(elem)
+
+	return elem, nil
+}
+
+// RemoveIf implements the Set interface.
+func (s *BitSet) RemoveIf(pred func(elem uint) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	before := s.count
+
+	for elem := range s.Elem() {
+		if pred(elem) {
+			s.Remove(elem)
+		}
+	}
+
+	return before - s.count
+}
+
+// Clone implements the Set interface.
+func (s BitSet) Clone() Set[uint] {
+	return &BitSet{
+		words: append([]uint64(nil), s.words...),
+		count: s.count,
+	}
+}
+
+// Clear implements the Set interface.
+func (s *BitSet) Clear() {
+	if s == nil {
+		return
+	}
+
+	clear(s.words)
+	s.count = 0
+}
+
+// Count returns the number of elements in the set; that is, the number of set
+// bits. It is the same as Size.
+//
+// Returns:
+//   - int: The number of elements. Never negative.
+func (s BitSet) Count() int {
+	return s.count
+}
+
+// NextSet returns the smallest element of the set that is greater than or equal
+// to i. Iterating with NextSet(i+1) from NextSet(0) visits every element.
+//
+// Parameters:
+//   - i: The element to start from.
+//
+// Returns:
+//   - uint: The found element.
+//   - bool: False if there is no such element.
+func (s BitSet) NextSet(i uint) (uint, bool) {
+	idx := i / wordSize
+	if idx >= uint(len(s.words)) {
+		return 0, false
+	}
+
+	w := s.words[idx] >> (i % wordSize)
+	if w != 0 {
+		return i + uint(bits.TrailingZeros64(w)), true
+	}
+
+	for idx++; idx < uint(len(s.words)); idx++ {
+		if s.words[idx] != 0 {
+			return idx*wordSize + uint(bits.TrailingZeros64(s.words[idx])), true
+		}
+	}
+
+	return 0, false
+}
+
+// Union adds every element of other to the set, one word at a time.
+//
+// Parameters:
+//   - other: The other set. Nil is treated as the empty set.
+//
+// Returns:
+//   - error: An error if the receiver is nil and other is not empty.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil and other is not empty.
+func (s *BitSet) Union(other *BitSet) error {
+	if other == nil || other.count == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	if len(other.words) > 0 {
+		s.grow(len(other.words) - 1)
+	}
+
+	for idx, w := range other.words {
+		s.words[idx] |= w
+	}
+
+	s.recount()
+
+	return nil
+}
+
+// Intersect removes from the set every element that is not in other, one word
+// at a time.
+//
+// Parameters:
+//   - other: The other set. Nil is treated as the empty set.
+func (s *BitSet) Intersect(other *BitSet) {
+	if s == nil {
+		return
+	} else if other == nil {
+		s.Clear()
+		return
+	}
+
+	for idx := range s.words {
+		if idx < len(other.words) {
+			s.words[idx] &= other.words[idx]
+		} else {
+			s.words[idx] = 0
+		}
+	}
+
+	s.recount()
+}
+
+// Difference removes from the set every element that is in other, one word at
+// a time.
+//
+// Parameters:
+//   - other: The other set. Nil is treated as the empty set.
+func (s *BitSet) Difference(other *BitSet) {
+	if s == nil || other == nil {
+		return
+	}
+
+	for idx := range min(len(s.words), len(other.words)) {
+		s.words[idx] &^= other.words[idx]
+	}
+
+	s.recount()
+}
+
+// mergeFrom implements the merger interface.
+func (s *BitSet) mergeFrom(other Set[uint]) bool {
+	o, ok := other.(*BitSet)
+	if !ok {
+		return false
+	}
+
+	_ = s.Union(o)
+
+	return true
+}
+
+// union implements the algebra interface.
+func (s BitSet) union(other Set[uint]) (Set[uint], error) {
+	res := s.Clone().(*BitSet)
+
+	err := Merge[uint](res, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// intersection implements the algebra interface.
+func (s BitSet) intersection(other Set[uint]) Set[uint] {
+	res := s.Clone().(*BitSet)
+
+	if o, ok := other.(*BitSet); ok {
+		res.Intersect(o)
+	} else {
+		res.RemoveIf(func(elem uint) bool {
+			return !other.Contains(elem)
+		})
+	}
+
+	return res
+}
+
+// difference implements the algebra interface.
+func (s BitSet) difference(other Set[uint]) Set[uint] {
+	res := s.Clone().(*BitSet)
+
+	if o, ok := other.(*BitSet); ok {
+		res.Difference(o)
+	} else {
+		for elem := range other.Elem() {
+			res.Remove(elem)
+		}
+	}
+
+	return res
+}
+
+// symmetricDifference implements the algebra interface.
+func (s BitSet) symmetricDifference(other Set[uint]) (Set[uint], error) {
+	res := s.Clone().(*BitSet)
+
+	if o, ok := other.(*BitSet); ok {
+		if len(o.words) > 0 {
+			res.grow(len(o.words) - 1)
+		}
+
+		for idx, w := range o.words {
+			res.words[idx] ^= w
+		}
+
+		res.recount()
+
+		return res, nil
+	}
+
+	for elem := range other.Elem() {
+		if res.Remove(elem) {
+			continue
+		}
+
+		err := res.Add(elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// subsetOf implements the subsetChecker interface.
+func (s BitSet) subsetOf(other Set[uint]) (bool, bool) {
+	o, ok := other.(*BitSet)
+	if !ok {
+		return false, false
+	}
+
+	for idx, w := range s.words {
+		var ow uint64
+
+		if idx < len(o.words) {
+			ow = o.words[idx]
+		}
+
+		if w&^ow != 0 {
+			return false, true
+		}
+	}
+
+	return true, true
+}
+
+// disjointFrom implements the subsetChecker interface.
+func (s BitSet) disjointFrom(other Set[uint]) (bool, bool) {
+	o, ok := other.(*BitSet)
+	if !ok {
+		return false, false
+	}
+
+	for idx := range min(len(s.words), len(o.words)) {
+		if s.words[idx]&o.words[idx] != 0 {
+			return false, true
+		}
+	}
+
+	return true, true
+}
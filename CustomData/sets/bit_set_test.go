@@ -0,0 +1,78 @@
+package sets
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// TestBitSet tests the word-level operations of BitSet.
+func TestBitSet(t *testing.T) {
+	s := NewBitSet(1, 64, 200)
+	other := NewBitSet(1, 2, 300)
+
+	var got []uint
+
+	for i, ok := s.NextSet(0); ok; i, ok = s.NextSet(i + 1) {
+		got = append(got, i)
+	}
+
+	if want := []uint{1, 64, 200}; !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	u := s.Clone().(*BitSet)
+
+	_ = u.Union(other)
+	if want := []uint{1, 2, 64, 200, 300}; !slices.Equal(slices.Collect(u.Elem()), want) || u.Count() != len(want) {
+		t.Errorf("want %v, got %v", want, slices.Collect(u.Elem()))
+	}
+
+	u.Intersect(s)
+	if !Equal[uint](u, s) {
+		t.Errorf("want %v, got %v", slices.Collect(s.Elem()), slices.Collect(u.Elem()))
+	}
+
+	u.Difference(other)
+	if want := []uint{64, 200}; !slices.Equal(slices.Collect(u.Elem()), want) || u.Count() != len(want) {
+		t.Errorf("want %v, got %v", want, slices.Collect(u.Elem()))
+	}
+
+	_ = Merge[uint](u, New[uint](5, 1000))
+	if !u.Contains(1000) || u.Size() != 4 {
+		t.Errorf("want 1000 to be merged, got %v", slices.Collect(u.Elem()))
+	}
+
+	res, _ := SymmetricDifference[uint](s, other)
+	if want := []uint{2, 64, 200, 300}; !slices.Equal(slices.Collect(res.Elem()), want) {
+		t.Errorf("want %v, got %v", want, slices.Collect(res.Elem()))
+	}
+}
+
+// TestBitSet_Bounds tests that elements above MaxBitSetElem are rejected
+// without allocating.
+func TestBitSet_Bounds(t *testing.T) {
+	var s BitSet
+
+	for _, elem := range []uint{MaxBitSetElem + 1, math.MaxUint} {
+		if err := s.Add(elem); !isBadParam(err) {
+			t.Errorf("want a bad parameter error, got %v", err)
+		}
+	}
+
+	if err := s.AddMany([]uint{1, math.MaxUint, 2}); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if want := []uint{1}; !slices.Equal(slices.Collect(s.Elem()), want) || len(s.words) != 1 {
+		t.Errorf("want %v in a single word, got %v", want, slices.Collect(s.Elem()))
+	}
+
+	if _, err := Union[uint](&s, New[uint](math.MaxUint)); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if got := NewBitSet(3, math.MaxUint); got.Size() != 1 || !got.Contains(3) {
+		t.Errorf("want {3}, got %v", slices.Collect(got.Elem()))
+	}
+}
@@ -0,0 +1,293 @@
+package sets
+
+import (
+	"math"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// MaxBloomBits is the greatest number of bits of a Bloom filter. A filter of
+// that size uses 512 MiB.
+const MaxBloomBits uint64 = 1 << 32
+
+// maxBloomHashes is the greatest number of bits an element may set. NewBloom
+// never needs more than about 1075, which is reached for the smallest positive
+// false-positive rate.
+const maxBloomHashes = 2048
+
+// Container is the read-only membership API of a set. Every Set is a Container.
+type Container[T any] interface {
+	// Contains checks whether the specified element is present.
+	//
+	// Parameters:
+	//   - elem: The element to check for presence.
+	//
+	// Returns:
+	//   - bool: True if the element is present, false otherwise.
+	Contains(elem T) bool
+}
+
+// Bloom is a Bloom filter: a compact probabilistic set that can tell for sure
+// that an element was never added, but may wrongly report that an element was
+// added with a configurable probability. Elements cannot be removed nor
+// iterated. A Bloom filter must be created with NewBloom.
+type Bloom[T any] struct {
+	// words are the bits of the filter.
+	words []uint64
+
+	// bits is the number of bits of the filter.
+	bits uint64
+
+	// hashes is the number of bits set by every element.
+	hashes uint64
+
+	// hash hashes the elements.
+	hash Hasher[T]
+}
+
+// bloomData is the serialized form of a Bloom filter.
+type bloomData struct {
+	// Words are the bits of the filter.
+	Words []uint64
+
+	// Bits is the number of bits of the filter.
+	Bits uint64
+
+	// Hashes is the number of bits set by every element.
+	Hashes uint64
+}
+
+// NewBloom creates a new, empty Bloom filter sized so that, once capacity
+// distinct elements were added, MayContain wrongly returns true with a
+// probability of at most fp_rate.
+//
+// Parameters:
+//   - capacity: The expected number of distinct elements.
+//   - fp_rate: The false-positive rate, in (0, 1).
+//   - hash: The hash function of the elements; for instance, HashString[string],
+//     HashBytes[[]byte] or HashInteger[int]. Elements of other comparable types
+//     can be hashed by combining the hashes of their fields.
+//
+// Returns:
+//   - *Bloom[T]: The new filter. Nil if an error occurred.
+//   - error: An error if the filter could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If capacity is not positive, fp_rate is not in (0, 1),
+//     hash is nil, or the filter would need more than MaxBloomBits bits.
+func NewBloom[T any](capacity int, fp_rate float64, hash Hasher[T]) (*Bloom[T], error) {
+	if capacity <= 0 {
+		return nil, common.NewErrBadParam("capacity", "must be positive")
+	} else if !(fp_rate > 0 && fp_rate < 1) {
+		return nil, common.NewErrBadParam("fp_rate", "must be in (0, 1)")
+	} else if hash == nil {
+		return nil, common.NewErrNilParam("hash")
+	}
+
+	n := float64(capacity)
+
+	size := math.Ceil(-n * math.Log(fp_rate) / (math.Ln2 * math.Ln2))
+	if size > float64(MaxBloomBits) {
+		return nil, common.NewErrBadParam("capacity", "needs more than MaxBloomBits bits at this fp_rate")
+	}
+
+	bits := uint64(size)
+	hashes := uint64(max(1, math.Round(float64(bits)/n*math.Ln2)))
+
+	return &Bloom[T]{
+		words:  make([]uint64, (bits+63)/64),
+		bits:   bits,
+		hashes: hashes,
+		hash:   hash,
+	}, nil
+}
+
+// positions calls fn with the position of every bit of the element, until fn
+// returns false. It uses double hashing: the i-th position is h1 + i*h2.
+func (b *Bloom[T]) positions(elem T, fn func(pos uint64) bool) {
+	h1 := b.hash(elem)
+	h2 := mix(h1) | 1
+
+	for i := range b.hashes {
+		if !fn((h1 + i*h2) % b.bits) {
+			return
+		}
+	}
+}
+
+// Add adds the element to the filter.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the element could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the filter was not created with NewBloom.
+func (b *Bloom[T]) Add(elem T) error {
+	if b == nil {
+		return common.ErrNilReceiver
+	} else if b.hash == nil || b.bits == 0 {
+		return common.NewErrNilParam("hash")
+	}
+
+	b.positions(elem, func(pos uint64) bool {
+		b.words[pos/64] |= 1 << (pos % 64)
+		return true
+	})
+
+	return nil
+}
+
+// MayContain checks whether the element may have been added to the filter.
+//
+// Parameters:
+//   - elem: The element to check.
+//
+// Returns:
+//   - bool: False if the element was never added; true if it was probably added.
+func (b *Bloom[T]) MayContain(elem T) bool {
+	if b == nil || b.hash == nil || b.bits == 0 {
+		return false
+	}
+
+	found := true
+
+	b.positions(elem, func(pos uint64) bool {
+		found = b.words[pos/64]&(1<<(pos%64)) != 0
+		return found
+	})
+
+	return found
+}
+
+// IsEmpty checks whether no element was added to the filter.
+//
+// Returns:
+//   - bool: True if the filter is empty, false otherwise.
+func (b *Bloom[T]) IsEmpty() bool {
+	if b == nil {
+		return true
+	}
+
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reset removes every element of the filter. Its size and hash function are
+// kept.
+func (b *Bloom[T]) Reset() {
+	if b == nil {
+		return
+	}
+
+	clear(b.words)
+}
+
+// Union adds every element of other to the filter. Both filters must have been
+// created with the same capacity, false-positive rate and hash function.
+//
+// Parameters:
+//   - other: The other filter. Nil is treated as the empty filter.
+//
+// Returns:
+//   - error: An error if the filters could not be merged.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil and other is not nil.
+//   - ErrIncompatibleFilters: If the filters do not have the same size.
+func (b *Bloom[T]) Union(other *Bloom[T]) error {
+	if other == nil {
+		return nil
+	} else if b == nil {
+		return common.ErrNilReceiver
+	} else if b.bits != other.bits || b.hashes != other.hashes {
+		return ErrIncompatibleFilters
+	}
+
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The hash function is not encoded.
+func (b Bloom[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(bloomData{
+		Words:  b.words,
+		Bits:   b.bits,
+		Hashes: b.hashes,
+	})
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+//
+// The receiver keeps its hash function, which must be the one of the encoded
+// filter; its size is replaced by the encoded one.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the filter was not created with NewBloom, or if
+//     the data is not a valid filter.
+func (b *Bloom[T]) UnmarshalBinary(data []byte) error {
+	if b == nil {
+		return common.ErrNilReceiver
+	} else if b.hash == nil {
+		return common.NewErrNilParam("hash")
+	}
+
+	var bd bloomData
+
+	err := decodeGob(data, &bd)
+	if err != nil {
+		return err
+	}
+
+	if bd.Bits == 0 || bd.Hashes == 0 {
+		return common.NewErrBadParam("data", "must encode a non-empty filter")
+	} else if bd.Bits > MaxBloomBits {
+		return common.NewErrBadParam("data", "must not have more than MaxBloomBits bits")
+	} else if bd.Hashes > min(bd.Bits, maxBloomHashes) {
+		return common.NewErrBadParam("data", "must not set more bits per element than the filter has")
+	} else if uint64(len(bd.Words)) != (bd.Bits+63)/64 {
+		return common.NewErrBadParam("data", "must have as many words as bits")
+	}
+
+	b.words = bd.Words
+	b.bits = bd.Bits
+	b.hashes = bd.Hashes
+
+	return nil
+}
+
+// AsContainer returns a read-only view of the filter whose Contains method is
+// MayContain.
+//
+// Returns:
+//   - Container[T]: The view. Never returns nil.
+func (b *Bloom[T]) AsContainer() Container[T] {
+	return bloomView[T]{
+		b: b,
+	}
+}
+
+// bloomView is the read-only view of a Bloom filter as a Container.
+type bloomView[T any] struct {
+	// b is the filter.
+	b *Bloom[T]
+}
+
+// Contains implements the Container interface.
+func (v bloomView[T]) Contains(elem T) bool {
+	return v.b.MayContain(elem)
+}
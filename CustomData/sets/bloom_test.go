@@ -0,0 +1,156 @@
+package sets
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+// TestBloom tests the false-positive rate, Union and serialization of Bloom.
+func TestBloom(t *testing.T) {
+	const n = 10_000
+
+	b, err := NewBloom(n, 0.01, HashString[string])
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	for i := range n {
+		_ = b.Add("file-" + strconv.Itoa(i))
+	}
+
+	for i := range n {
+		if !b.MayContain("file-" + strconv.Itoa(i)) {
+			t.Fatalf("want no false negative, got one for %d", i)
+		}
+	}
+
+	var fp int
+
+	for i := range n {
+		if b.MayContain("other-" + strconv.Itoa(i)) {
+			fp++
+		}
+	}
+
+	if rate := float64(fp) / n; rate > 0.02 {
+		t.Errorf("want a false-positive rate of about 0.01, got %f", rate)
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	decoded, _ := NewBloom(1, 0.5, HashString[string])
+
+	err = decoded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	if !decoded.AsContainer().Contains("file-42") {
+		t.Errorf("want file-42 to be in the decoded filter")
+	}
+
+	other, _ := NewBloom(n, 0.01, HashString[string])
+	_ = other.Add("extra")
+
+	err = b.Union(other)
+	if err != nil || !b.MayContain("extra") {
+		t.Errorf("want extra to be merged, got %v", err)
+	}
+
+	small, _ := NewBloom(10, 0.01, HashString[string])
+
+	err = b.Union(small)
+	if !errors.Is(err, ErrIncompatibleFilters) {
+		t.Errorf("want %v, got %v", ErrIncompatibleFilters, err)
+	}
+}
+
+// TestBloom_Zero tests a Bloom filter that was not created with NewBloom.
+func TestBloom_Zero(t *testing.T) {
+	var b Bloom[string]
+
+	if err := b.Add("a"); err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	if b.MayContain("a") || !b.IsEmpty() {
+		t.Errorf("want an empty filter")
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	if err := b.UnmarshalBinary(data); err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	other, _ := NewBloom(10, 0.01, HashString[string])
+
+	if err := other.UnmarshalBinary(data); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if err := other.Union(&b); !errors.Is(err, ErrIncompatibleFilters) {
+		t.Errorf("want %v, got %v", ErrIncompatibleFilters, err)
+	}
+}
+
+// TestBloom_Malformed tests that UnmarshalBinary rejects malformed payloads and
+// leaves the filter unchanged.
+func TestBloom_Malformed(t *testing.T) {
+	b, _ := NewBloom(10, 0.01, HashString[string])
+	_ = b.Add("a")
+
+	tests := map[string]bloomData{
+		"too many hashes": {Words: make([]uint64, 1), Bits: 64, Hashes: 65},
+		"huge hashes":     {Words: make([]uint64, 1<<10), Bits: 1 << 16, Hashes: 1 << 62},
+		"too few words":   {Words: make([]uint64, 1), Bits: 128, Hashes: 3},
+		"no bits":         {Words: nil, Bits: 0, Hashes: 3},
+	}
+
+	for name, bd := range tests {
+		data, err := encodeGob(bd)
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+
+		if err := b.UnmarshalBinary(data); !isBadParam(err) {
+			t.Errorf("%s: want a bad parameter error, got %v", name, err)
+		}
+	}
+
+	if err := b.UnmarshalBinary([]byte("not a filter")); err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	if !b.MayContain("a") {
+		t.Errorf("want the filter to be unchanged")
+	}
+}
+
+// TestBloom_TooLarge tests that NewBloom rejects filters larger than
+// MaxBloomBits instead of allocating them.
+func TestBloom_TooLarge(t *testing.T) {
+	tests := []struct {
+		capacity int
+		fp_rate  float64
+	}{
+		{math.MaxInt, 1e-300},
+		{1 << 40, 0.01},
+		{1 << 30, 1e-300},
+	}
+
+	for _, test := range tests {
+		b, err := NewBloom(test.capacity, test.fp_rate, HashInteger[int])
+		if !isBadParam(err) || b != nil {
+			t.Errorf("NewBloom(%d, %g): want a bad parameter error, got %v", test.capacity, test.fp_rate, err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package sets_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/PlayerR9/mysd-lib/CustomData/containertest"
+	"github.com/PlayerR9/mysd-lib/CustomData/sets"
+)
+
+// TestSets runs the Set suite against every set of the package.
+func TestSets(t *testing.T) {
+	elems := []int{3, 1, 4, 5}
+
+	t.Run("New", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			return sets.New[int]()
+		}, elems)
+	})
+
+	t.Run("OrderedSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			return sets.NewOrderedSet[int](nil)
+		}, elems)
+	})
+
+	t.Run("OrderedFuncSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			s, _ := sets.NewOrderedFuncSet(cmp.Compare[int], nil)
+			return s
+		}, elems)
+	})
+
+	t.Run("TreeSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			return sets.NewTreeSet[int](nil)
+		}, elems)
+	})
+
+	t.Run("BitSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[uint] {
+			return sets.NewBitSet()
+		}, []uint{3, 1, 400, 64})
+	})
+
+	t.Run("Multiset", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			return sets.NewMultiset[int]().AsSet()
+		}, elems)
+	})
+
+	t.Run("LinkedSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			return sets.NewLinkedSet[int]()
+		}, elems)
+	})
+
+	t.Run("IntervalSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			s, _ := sets.NewIntervalSet(sets.Successor[int], sets.Distance[int])
+			return s
+		}, elems)
+	})
+
+	t.Run("Sync", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			s, _ := sets.NewSync(sets.New[int]())
+			return s
+		}, elems)
+	})
+
+	t.Run("ShardedSet", func(t *testing.T) {
+		containertest.TestSet(t, func() sets.Set[int] {
+			s, _ := sets.NewShardedSet(4, sets.HashInteger[int])
+			return s
+		}, elems)
+	})
+}
@@ -0,0 +1,275 @@
+package sets
+
+import (
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// DisjointSet is a union-find structure: it partitions its elements into
+// disjoint classes that can be merged. It uses path compression and union by
+// rank, so every operation takes nearly constant amortized time. An empty
+// disjoint set can either be created with the `var set DisjointSet[T]` syntax
+// or with the `new(DisjointSet[T])` constructor.
+type DisjointSet[T comparable] struct {
+	// index maps every element to its position in elems.
+	index map[T]int
+
+	// elems are the elements, in the order they were added.
+	elems []T
+
+	// parent is the position of the parent of every element. A root is its own
+	// parent and is the representative of its class.
+	parent []int
+
+	// rank is an upper bound of the height of every root.
+	rank []int
+
+	// size is the number of elements of the class of every root.
+	size []int
+
+	// count is the number of classes.
+	count int
+}
+
+// NewDisjointSet creates a new disjoint set where every provided element is in
+// its own class.
+//
+// Parameters:
+//   - elems: The elements to add.
+//
+// Returns:
+//   - *DisjointSet[T]: The new disjoint set. Never returns nil.
+func NewDisjointSet[T comparable](elems ...T) *DisjointSet[T] {
+	d := new(DisjointSet[T])
+
+	for _, elem := range elems {
+		d.add(elem)
+	}
+
+	return d
+}
+
+// add adds the element in its own class if it is not already present.
+//
+// Returns:
+//   - int: The position of the element.
+func (d *DisjointSet[T]) add(elem T) int {
+	if pos, ok := d.index[elem]; ok {
+		return pos
+	}
+
+	if d.index == nil {
+		d.index = make(map[T]int)
+	}
+
+	pos := len(d.elems)
+
+	d.index[elem] = pos
+	d.elems = append(d.elems, elem)
+	d.parent = append(d.parent, pos)
+	d.rank = append(d.rank, 0)
+	d.size = append(d.size, 1)
+	d.count++
+
+	return pos
+}
+
+// root returns the position of the representative of the element at the given
+// position, compressing the path to it.
+func (d *DisjointSet[T]) root(pos int) int {
+	r := pos
+	for d.parent[r] != r {
+		r = d.parent[r]
+	}
+
+	for d.parent[pos] != r {
+		pos, d.parent[pos] = d.parent[pos], r
+	}
+
+	return r
+}
+
+// Add adds the element in its own class if it is not already present.
+//
+// Parameters:
+//   - elem: The element to add.
+//
+// Returns:
+//   - error: An error if the receiver is nil.
+func (d *DisjointSet[T]) Add(elem T) error {
+	if d == nil {
+		return common.ErrNilReceiver
+	}
+
+	d.add(elem)
+
+	return nil
+}
+
+// Len returns the number of elements of the disjoint set.
+//
+// Returns:
+//   - int: The number of elements. Never negative.
+func (d *DisjointSet[T]) Len() int {
+	if d == nil {
+		return 0
+	}
+
+	return len(d.elems)
+}
+
+// Count returns the number of classes of the disjoint set.
+//
+// Returns:
+//   - int: The number of classes. Never negative.
+func (d *DisjointSet[T]) Count() int {
+	if d == nil {
+		return 0
+	}
+
+	return d.count
+}
+
+// Reset removes every element of the disjoint set.
+func (d *DisjointSet[T]) Reset() {
+	if d == nil {
+		return
+	}
+
+	clear(d.index)
+	d.index = nil
+
+	clear(d.elems)
+	d.elems = nil
+	d.parent = nil
+	d.rank = nil
+	d.size = nil
+	d.count = 0
+}
+
+// Find returns the representative of the class of the element. Two elements
+// are in the same class if and only if they have the same representative.
+//
+// Parameters:
+//   - elem: The element.
+//
+// Returns:
+//   - T: The representative of the class of elem.
+//   - bool: False if elem is not in the disjoint set.
+func (d *DisjointSet[T]) Find(elem T) (T, bool) {
+	if d == nil {
+		return *new(T), false
+	}
+
+	pos, ok := d.index[elem]
+	if !ok {
+		return *new(T), false
+	}
+
+	return d.elems[d.root(pos)], true
+}
+
+// Union merges the classes of the two elements. Elements that are not in the
+// disjoint set are added first.
+//
+// Parameters:
+//   - a: The first element.
+//   - b: The second element.
+//
+// Returns:
+//   - bool: True if the classes were merged, false if a and b were already in
+//     the same class.
+//   - error: An error if the receiver is nil.
+func (d *DisjointSet[T]) Union(a, b T) (bool, error) {
+	if d == nil {
+		return false, common.ErrNilReceiver
+	}
+
+	ra, rb := d.root(d.add(a)), d.root(d.add(b))
+	if ra == rb {
+		return false, nil
+	}
+
+	if d.rank[ra] < d.rank[rb] {
+		ra, rb = rb, ra
+	} else if d.rank[ra] == d.rank[rb] {
+		d.rank[ra]++
+	}
+
+	d.parent[rb] = ra
+	d.size[ra] += d.size[rb]
+	d.count--
+
+	return true, nil
+}
+
+// Connected checks whether the two elements are in the same class.
+//
+// Parameters:
+//   - a: The first element.
+//   - b: The second element.
+//
+// Returns:
+//   - bool: True if a and b are both in the disjoint set and in the same
+//     class, false otherwise.
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	ra, ok := d.Find(a)
+	if !ok {
+		return false
+	}
+
+	rb, ok := d.Find(b)
+
+	return ok && ra == rb
+}
+
+// Size returns the number of elements of the class of the element.
+//
+// Parameters:
+//   - elem: The element.
+//
+// Returns:
+//   - int: The size of the class of elem. 0 if elem is not in the disjoint set.
+func (d *DisjointSet[T]) Size(elem T) int {
+	if d == nil {
+		return 0
+	}
+
+	pos, ok := d.index[elem]
+	if !ok {
+		return 0
+	}
+
+	return d.size[d.root(pos)]
+}
+
+// Classes returns every class of the disjoint set as a new set. The classes are
+// ordered by the first time one of their elements was added.
+//
+// Returns:
+//   - []Set[T]: The classes.
+func (d *DisjointSet[T]) Classes() []Set[T] {
+	if d == nil || d.count == 0 {
+		return nil
+	}
+
+	classes := make([]Set[T], 0, d.count)
+	of := make(map[int]*baseSet[T], d.count)
+
+	for pos, elem := range d.elems {
+		r := d.root(pos)
+
+		class, ok := of[r]
+		if !ok {
+			class = &baseSet[T]{
+				elems: make(map[T]struct{}, d.size[r]),
+			}
+
+			of[r] = class
+			classes = append(classes, class)
+		}
+
+		class.elems[elem] = struct{}{}
+	}
+
+	return classes
+}
@@ -0,0 +1,51 @@
+package sets
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestDisjointSet tests Union, Find, Connected, Size and Classes.
+func TestDisjointSet(t *testing.T) {
+	d := NewDisjointSet(1, 2, 3, 4, 5)
+
+	for _, pair := range [][2]int{{1, 2}, {3, 4}, {2, 4}, {6, 7}} {
+		_, err := d.Union(pair[0], pair[1])
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+	}
+
+	merged, _ := d.Union(1, 3)
+	if merged {
+		t.Errorf("want 1 and 3 to be already connected")
+	}
+
+	if !d.Connected(1, 4) || d.Connected(1, 5) || d.Connected(1, 8) {
+		t.Errorf("want 1 connected to 4 only")
+	}
+
+	if d.Size(3) != 4 || d.Size(5) != 1 || d.Size(8) != 0 {
+		t.Errorf("want sizes 4, 1 and 0, got %d, %d and %d", d.Size(3), d.Size(5), d.Size(8))
+	}
+
+	r1, _ := d.Find(1)
+	r4, _ := d.Find(4)
+
+	if r1 != r4 {
+		t.Errorf("want the same representative, got %d and %d", r1, r4)
+	}
+
+	classes := d.Classes()
+	if len(classes) != d.Count() || len(classes) != 3 {
+		t.Fatalf("want 3 classes, got %d", len(classes))
+	}
+
+	want := [][]int{{1, 2, 3, 4}, {5}, {6, 7}}
+
+	for i, class := range classes {
+		if got := slices.Sorted(class.Elem()); !slices.Equal(got, want[i]) {
+			t.Errorf("want %v, got %v", want[i], got)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package sets
+
+import "errors"
+
+var (
+	// ErrEmptySet occurs when an element is popped from an empty set. This error
+	// can be checked with the == operator.
+	//
+	// Format:
+	// 	"empty set"
+	ErrEmptySet error
+
+	// ErrOutOfBounds occurs when an index is not in the bounds of a set. This error
+	// can be checked with the == operator.
+	//
+	// Format:
+	// 	"index out of bounds"
+	ErrOutOfBounds error
+
+	// ErrIncompatibleFilters occurs when two Bloom filters of different sizes are
+	// merged. This error can be checked with the == operator.
+	//
+	// Format:
+	// 	"incompatible filters"
+	ErrIncompatibleFilters error
+)
+
+func init() {
+	ErrEmptySet = errors.New("empty set")
+	ErrOutOfBounds = errors.New("index out of bounds")
+	ErrIncompatibleFilters = errors.New("incompatible filters")
+}
@@ -0,0 +1,61 @@
+package sets
+
+import (
+	"hash/fnv"
+)
+
+// Hasher hashes elements of type T. Equal elements must have the same hash, and
+// the hash of an element must not change over time nor between processes.
+type Hasher[T any] func(elem T) uint64
+
+// mix scrambles the bits of a hash so that every bit of the input affects every
+// bit of the output; that is, the finalizer of SplitMix64.
+func mix(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+
+	return h
+}
+
+// HashString is a Hasher of strings.
+//
+// Parameters:
+//   - elem: The string to hash.
+//
+// Returns:
+//   - uint64: The hash of elem.
+func HashString[T ~string](elem T) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(elem))
+
+	return mix(h.Sum64())
+}
+
+// HashBytes is a Hasher of byte slices. Slices with the same content have the
+// same hash.
+//
+// Parameters:
+//   - elem: The bytes to hash.
+//
+// Returns:
+//   - uint64: The hash of elem.
+func HashBytes[T ~[]byte](elem T) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(elem)
+
+	return mix(h.Sum64())
+}
+
+// HashInteger is a Hasher of integers.
+//
+// Parameters:
+//   - elem: The integer to hash.
+//
+// Returns:
+//   - uint64: The hash of elem.
+func HashInteger[T Integer](elem T) uint64 {
+	return mix(uint64(elem))
+}
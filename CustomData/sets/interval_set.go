@@ -0,0 +1,572 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Successor returns the integer that follows v. It is meant to be the successor
+// function of an IntervalSet of integers or runes.
+//
+// Parameters:
+//   - v: The integer.
+//
+// Returns:
+//   - T: v + 1.
+func Successor[T Integer](v T) T {
+	return v + 1
+}
+
+// Distance returns the number of integers in the half-open range [lo, hi). It
+// is meant to be the distance function of an IntervalSet of integers or runes.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - int: hi - lo, 0 if hi is not greater than lo, and math.MaxInt if the
+//     result does not fit in an int.
+func Distance[T Integer](lo, hi T) int {
+	if hi <= lo {
+		return 0
+	}
+
+	// The subtraction wraps around in two's complement, which gives the right
+	// result for signed types too.
+	d := uint64(hi) - uint64(lo)
+	if d > math.MaxInt {
+		return math.MaxInt
+	}
+
+	return int(d)
+}
+
+// interval is the half-open range [lo, hi) of an IntervalSet.
+type interval[T cmp.Ordered] struct {
+	// lo is the smallest value of the range.
+	lo T
+
+	// hi is the first value after the range.
+	hi T
+}
+
+// IntervalSet is a set of ordered values stored as merged, non-overlapping
+// half-open ranges; for instance, the character class [a-zA-Z0-9_] is made of
+// four ranges no matter how many runes they hold. Point operations rely on the
+// successor function the set was created with: the single value elem is the
+// range [elem, succ(elem)). Since the ranges are half-open, the greatest value
+// of T, which has no successor, can never be in the set. An IntervalSet must be
+// created with NewIntervalSet.
+type IntervalSet[T cmp.Ordered] struct {
+	// ranges are the ranges of the set, in ascending order. Two ranges never
+	// overlap nor touch.
+	ranges []interval[T]
+
+	// succ returns the value that follows its argument.
+	succ func(v T) T
+
+	// dist returns the number of values of a range.
+	dist func(lo, hi T) int
+
+	// size is the number of values of the set.
+	size int
+}
+
+// NewIntervalSet creates a new, empty interval set.
+//
+// Parameters:
+//   - succ: The function that returns the value that follows its argument; for
+//     instance, Successor[rune]. It must be strictly increasing, except on the
+//     greatest value of T.
+//   - dist: The function that returns the number of values of a half-open
+//     range; for instance, Distance[rune]. It must agree with succ.
+//
+// Returns:
+//   - *IntervalSet[T]: The new set. Nil if an error occurred.
+//   - error: An error if the set could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If succ or dist is nil.
+func NewIntervalSet[T cmp.Ordered](succ func(v T) T, dist func(lo, hi T) int) (*IntervalSet[T], error) {
+	if succ == nil {
+		return nil, common.NewErrNilParam("succ")
+	} else if dist == nil {
+		return nil, common.NewErrNilParam("dist")
+	}
+
+	return &IntervalSet[T]{
+		succ: succ,
+		dist: dist,
+	}, nil
+}
+
+// NewIntegerSet creates a new, empty interval set of integers or runes. It is
+// the same as NewIntervalSet(Successor[T], Distance[T]).
+//
+// Returns:
+//   - *IntervalSet[T]: The new set. Never returns nil.
+func NewIntegerSet[T Integer]() *IntervalSet[T] {
+	return &IntervalSet[T]{
+		succ: Successor[T],
+		dist: Distance[T],
+	}
+}
+
+// check checks that the set was created with NewIntervalSet.
+//
+// Returns:
+//   - error: An error if the set cannot be modified.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewIntervalSet.
+func (s *IntervalSet[T]) check() error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if s.succ == nil {
+		return common.NewErrNilParam("succ")
+	} else if s.dist == nil {
+		return common.NewErrNilParam("dist")
+	}
+
+	return nil
+}
+
+// recount updates the size of the set from its ranges. Counts that do not fit
+// in an int are capped at math.MaxInt.
+func (s *IntervalSet[T]) recount() {
+	s.size = 0
+
+	if s.dist == nil {
+		return
+	}
+
+	for _, r := range s.ranges {
+		n := s.dist(r.lo, r.hi)
+		if n > math.MaxInt-s.size {
+			s.size = math.MaxInt
+			return
+		}
+
+		s.size += n
+	}
+}
+
+// AddRange adds every value of the half-open range [lo, hi) to the set.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound. If it is not greater than lo, nothing is
+//     added.
+//
+// Returns:
+//   - error: An error if the range could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewIntervalSet.
+func (s *IntervalSet[T]) AddRange(lo, hi T) error {
+	err := s.check()
+	if err != nil {
+		return err
+	} else if cmp.Compare(lo, hi) >= 0 {
+		return nil
+	}
+
+	// i is the first range that ends at or after lo; j is the first range that
+	// starts after hi. The ranges in [i, j) overlap or touch [lo, hi).
+	i, _ := slices.BinarySearchFunc(s.ranges, lo, func(r interval[T], v T) int {
+		return cmp.Compare(r.hi, v)
+	})
+
+	j := i
+	for j < len(s.ranges) && cmp.Compare(s.ranges[j].lo, hi) <= 0 {
+		j++
+	}
+
+	if i < j {
+		lo = min(lo, s.ranges[i].lo)
+		hi = max(hi, s.ranges[j-1].hi)
+	}
+
+	s.ranges = slices.Replace(s.ranges, i, j, interval[T]{lo: lo, hi: hi})
+	s.recount()
+
+	return nil
+}
+
+// RemoveRange removes every value of the half-open range [lo, hi) from the set.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound. If it is not greater than lo, nothing is
+//     removed.
+func (s *IntervalSet[T]) RemoveRange(lo, hi T) {
+	if s == nil || cmp.Compare(lo, hi) >= 0 {
+		return
+	}
+
+	// i is the first range that ends after lo; j is the first range that starts
+	// at or after hi. The ranges in [i, j) overlap [lo, hi).
+	i, _ := slices.BinarySearchFunc(s.ranges, lo, func(r interval[T], v T) int {
+		if cmp.Compare(r.hi, v) <= 0 {
+			return -1
+		}
+
+		return 1
+	})
+
+	j := i
+	for j < len(s.ranges) && cmp.Compare(s.ranges[j].lo, hi) < 0 {
+		j++
+	}
+
+	if i == j {
+		return
+	}
+
+	var pieces []interval[T]
+
+	if first := s.ranges[i]; cmp.Compare(first.lo, lo) < 0 {
+		pieces = append(pieces, interval[T]{lo: first.lo, hi: lo})
+	}
+
+	if last := s.ranges[j-1]; cmp.Compare(last.hi, hi) > 0 {
+		pieces = append(pieces, interval[T]{lo: hi, hi: last.hi})
+	}
+
+	s.ranges = slices.Replace(s.ranges, i, j, pieces...)
+	s.recount()
+}
+
+// Ranges iterates, in ascending order, through the ranges of the set. Every
+// range is yielded as its inclusive lower bound and its exclusive upper bound.
+//
+// Returns:
+//   - iter.Seq2[T, T]: The bounds of the ranges. Never returns nil.
+func (s *IntervalSet[T]) Ranges() iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		if s == nil {
+			return
+		}
+
+		for _, r := range s.ranges {
+			if !yield(r.lo, r.hi) {
+				return
+			}
+		}
+	}
+}
+
+// Complement returns a new set with the values of [lo, hi) that are not in the
+// set. The new set has the successor and distance functions of the receiver.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - *IntervalSet[T]: The complement. Nil if an error occurred.
+//   - error: An error if the complement could not be computed.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewIntervalSet.
+func (s *IntervalSet[T]) Complement(lo, hi T) (*IntervalSet[T], error) {
+	err := s.check()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &IntervalSet[T]{
+		succ: s.succ,
+		dist: s.dist,
+	}
+
+	_ = res.AddRange(lo, hi)
+
+	for _, r := range s.ranges {
+		res.RemoveRange(r.lo, r.hi)
+	}
+
+	return res, nil
+}
+
+// Union adds every value of other to the set.
+//
+// Parameters:
+//   - other: The other set. Nil is treated as the empty set.
+//
+// Returns:
+//   - error: An error if the receiver is nil and other is not empty.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil and other is not empty.
+//   - common.ErrBadParam: If the set was not created with NewIntervalSet and
+//     other is not empty.
+func (s *IntervalSet[T]) Union(other *IntervalSet[T]) error {
+	if other == nil || len(other.ranges) == 0 {
+		return nil
+	}
+
+	err := s.check()
+	if err != nil {
+		return err
+	}
+
+	all := make([]interval[T], 0, len(s.ranges)+len(other.ranges))
+	all = append(all, s.ranges...)
+	all = append(all, other.ranges...)
+
+	slices.SortFunc(all, func(a, b interval[T]) int {
+		return cmp.Compare(a.lo, b.lo)
+	})
+
+	merged := all[:1]
+
+	for _, r := range all[1:] {
+		last := &merged[len(merged)-1]
+
+		if cmp.Compare(r.lo, last.hi) <= 0 {
+			last.hi = max(last.hi, r.hi)
+		} else {
+			merged = append(merged, r)
+		}
+	}
+
+	s.ranges = merged
+	s.recount()
+
+	return nil
+}
+
+// Intersect removes from the set every value that is not in other.
+//
+// Parameters:
+//   - other: The other set. Nil is treated as the empty set.
+func (s *IntervalSet[T]) Intersect(other *IntervalSet[T]) {
+	if s == nil {
+		return
+	} else if other == nil {
+		s.Clear()
+		return
+	}
+
+	var res []interval[T]
+
+	var i, j int
+
+	for i < len(s.ranges) && j < len(other.ranges) {
+		a, b := s.ranges[i], other.ranges[j]
+
+		lo, hi := max(a.lo, b.lo), min(a.hi, b.hi)
+		if cmp.Compare(lo, hi) < 0 {
+			res = append(res, interval[T]{lo: lo, hi: hi})
+		}
+
+		if cmp.Compare(a.hi, b.hi) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	s.ranges = res
+	s.recount()
+}
+
+// Size implements the Set interface.
+//
+// The size is kept up to date by every change of the set and is capped at
+// math.MaxInt.
+func (s *IntervalSet[T]) Size() int {
+	if s == nil {
+		return 0
+	}
+
+	return s.size
+}
+
+// IsEmpty implements the Set interface.
+func (s *IntervalSet[T]) IsEmpty() bool {
+	return s == nil || len(s.ranges) == 0
+}
+
+// Reset implements the Set interface.
+//
+// The successor function is kept.
+func (s *IntervalSet[T]) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.ranges = nil
+	s.recount()
+}
+
+// Add implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewIntervalSet, or if
+//     elem has no successor; that is, elem is the greatest value of T.
+func (s *IntervalSet[T]) Add(elem T) error {
+	err := s.check()
+	if err != nil {
+		return err
+	}
+
+	next := s.succ(elem)
+	if cmp.Compare(next, elem) <= 0 {
+		return common.NewErrBadParam("elem", "must have a successor")
+	}
+
+	return s.AddRange(elem, next)
+}
+
+// AddMany implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewIntervalSet.
+func (s *IntervalSet[T]) AddMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	}
+
+	for _, elem := range elems {
+		err := s.Add(elem)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (s *IntervalSet[T]) Contains(elem T) bool {
+	if s == nil {
+		return false
+	}
+
+	i, _ := slices.BinarySearchFunc(s.ranges, elem, func(r interval[T], v T) int {
+		if cmp.Compare(r.hi, v) <= 0 {
+			return -1
+		}
+
+		return 1
+	})
+
+	return i < len(s.ranges) && cmp.Compare(s.ranges[i].lo, elem) <= 0
+}
+
+// Elem implements the Set interface.
+//
+// The values are iterated in ascending order.
+func (s *IntervalSet[T]) Elem() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil || s.succ == nil {
+			return
+		}
+
+		for _, r := range s.ranges {
+			for v := r.lo; cmp.Compare(v, r.hi) < 0; v = s.succ(v) {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Remove implements the Set interface.
+func (s *IntervalSet[T]) Remove(elem T) bool {
+	if !s.Contains(elem) || s.succ == nil {
+		return false
+	}
+
+	s.RemoveRange(elem, s.succ(elem))
+
+	return true
+}
+
+// RemoveMany implements the Set interface.
+func (s *IntervalSet[T]) RemoveMany(elems []T) int {
+	var count int
+
+	for _, elem := range elems {
+		if s.Remove(elem) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed value is the smallest one.
+func (s *IntervalSet[T]) Pop() (T, error) {
+	if s.IsEmpty() || s.succ == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	elem := s.ranges[0].lo
+	s.Remove(elem)
+
+	return elem, nil
+}
+
+// RemoveIf implements the Set interface.
+func (s *IntervalSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	var removed []T
+
+	for elem := range s.Elem() {
+		if pred(elem) {
+			removed = append(removed, elem)
+		}
+	}
+
+	return s.RemoveMany(removed)
+}
+
+// Clone implements the Set interface.
+func (s *IntervalSet[T]) Clone() Set[T] {
+	if s == nil {
+		return &IntervalSet[T]{}
+	}
+
+	return &IntervalSet[T]{
+		ranges: slices.Clone(s.ranges),
+		succ:   s.succ,
+		dist:   s.dist,
+		size:   s.size,
+	}
+}
+
+// Clear implements the Set interface.
+func (s *IntervalSet[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	clear(s.ranges)
+	s.ranges = s.ranges[:0]
+	s.recount()
+}
@@ -0,0 +1,172 @@
+package sets
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// isBadParam checks whether err is a common.ErrBadParam.
+func isBadParam(err error) bool {
+	var e *common.ErrBadParam
+	return errors.As(err, &e)
+}
+
+// collectRanges returns the ranges of the set as pairs of bounds.
+func collectRanges[T Integer](s *IntervalSet[T]) [][2]T {
+	var res [][2]T
+
+	for lo, hi := range s.Ranges() {
+		res = append(res, [2]T{lo, hi})
+	}
+
+	return res
+}
+
+// TestIntervalSet tests the range operations of IntervalSet on a rune class.
+func TestIntervalSet(t *testing.T) {
+	s, err := NewIntervalSet(Successor[rune], Distance[rune])
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	// [a-zA-Z0-9_]
+	_ = s.AddRange('a', 'z'+1)
+	_ = s.AddRange('A', 'Z'+1)
+	_ = s.AddRange('0', '9'+1)
+	_ = s.Add('_')
+
+	if got := len(collectRanges(s)); got != 4 {
+		t.Errorf("want 4 ranges, got %d", got)
+	}
+
+	if s.Size() != 63 || !s.Contains('q') || !s.Contains('_') || s.Contains('-') {
+		t.Errorf("want the class [a-zA-Z0-9_], got %v", collectRanges(s))
+	}
+
+	// Touching ranges are merged.
+	_ = s.AddRange('[', '_')
+	if want := [][2]rune{{'0', '9' + 1}, {'A', '`'}, {'a', 'z' + 1}}; !slices.Equal(collectRanges(s), want) {
+		t.Errorf("want %v, got %v", want, collectRanges(s))
+	}
+
+	s.RemoveRange('B', 'y')
+	if want := [][2]rune{{'0', '9' + 1}, {'A', 'B'}, {'y', 'z' + 1}}; !slices.Equal(collectRanges(s), want) {
+		t.Errorf("want %v, got %v", want, collectRanges(s))
+	}
+
+	c, err := s.Complement('0', 'z'+1)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	if want := [][2]rune{{'9' + 1, 'A'}, {'B', 'y'}}; !slices.Equal(collectRanges(c), want) {
+		t.Errorf("want %v, got %v", want, collectRanges(c))
+	}
+
+	other, _ := NewIntervalSet(Successor[rune], Distance[rune])
+	_ = other.AddRange('5', 'C')
+
+	u := s.Clone().(*IntervalSet[rune])
+	_ = u.Union(other)
+
+	if want := [][2]rune{{'0', 'C'}, {'y', 'z' + 1}}; !slices.Equal(collectRanges(u), want) {
+		t.Errorf("want %v, got %v", want, collectRanges(u))
+	}
+
+	s.Intersect(other)
+	if want := [][2]rune{{'5', '9' + 1}, {'A', 'B'}}; !slices.Equal(collectRanges(s), want) {
+		t.Errorf("want %v, got %v", want, collectRanges(s))
+	}
+
+	if s.Size() != 6 {
+		t.Errorf("want size 6, got %d", s.Size())
+	}
+}
+
+// TestIntervalSet_Size tests that the size of huge ranges is computed from
+// their bounds.
+func TestIntervalSet_Size(t *testing.T) {
+	s := NewIntegerSet[int64]()
+
+	_ = s.AddRange(0, 1<<40)
+	if s.Size() != 1<<40 {
+		t.Errorf("want size %d, got %d", 1<<40, s.Size())
+	}
+
+	s.RemoveRange(10, 20)
+	if s.Size() != 1<<40-10 {
+		t.Errorf("want size %d, got %d", 1<<40-10, s.Size())
+	}
+
+	other := NewIntegerSet[int64]()
+	_ = other.AddRange(5, 15)
+
+	if IsSubset[int64](other, s) || Equal[int64](other, s) {
+		t.Errorf("want %v not to be a subset of %v", collectRanges(other), collectRanges(s))
+	}
+
+	_ = s.AddRange(math.MinInt64, math.MaxInt64)
+	if s.Size() != math.MaxInt {
+		t.Errorf("want size %d, got %d", math.MaxInt, s.Size())
+	}
+}
+
+// TestIntervalSet_Bounds tests that the greatest value, which has no successor,
+// is rejected.
+func TestIntervalSet_Bounds(t *testing.T) {
+	s := NewIntegerSet[uint8]()
+
+	err := s.Add(math.MaxUint8)
+	if !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Errorf("want an empty set, got %v", collectRanges(s))
+	}
+
+	_ = s.Add(math.MaxUint8 - 1)
+	if !s.Contains(math.MaxUint8-1) || s.Size() != 1 {
+		t.Errorf("want {254}, got %v", collectRanges(s))
+	}
+
+	c, _ := s.Complement(0, math.MaxUint8)
+	if c.Size() != math.MaxUint8-1 {
+		t.Errorf("want size %d, got %d", math.MaxUint8-1, c.Size())
+	}
+}
+
+// TestIntervalSet_Zero tests that a set that was not created with
+// NewIntervalSet cannot be modified.
+func TestIntervalSet_Zero(t *testing.T) {
+	var s IntervalSet[int]
+
+	if err := s.AddRange(0, 10); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	other := NewIntegerSet[int]()
+	_ = other.AddRange(0, 10)
+
+	if err := s.Union(other); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Errorf("want an empty set, got %v", collectRanges(&s))
+	}
+
+	if _, err := s.Complement(0, 10); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	var nil_set *IntervalSet[int]
+
+	if _, err := nil_set.Complement(0, 10); err != common.ErrNilReceiver {
+		t.Errorf("want %v, got %v", common.ErrNilReceiver, err)
+	}
+}
@@ -0,0 +1,228 @@
+package sets
+
+import (
+	"iter"
+
+	"github.com/PlayerR9/mysd-lib/CustomData/listlike"
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// LinkedSet is a set that remembers the order in which its elements were first
+// added: Elem always iterates through them in that order. Adding an element
+// that is already present does not move it. An empty linked set can either be
+// created with the `var set LinkedSet[T]` syntax or with the
+// `new(LinkedSet[T])` constructor; a LinkedSet must not be copied.
+type LinkedSet[T comparable] struct {
+	// order holds the elements in insertion order.
+	order listlike.List[T]
+
+	// index maps every element to its position in order.
+	index map[T]*listlike.Element[T]
+}
+
+// NewLinkedSet creates a new linked set from the provided elements, in order.
+//
+// Parameters:
+//   - elems: The elements to add to the set.
+//
+// Returns:
+//   - *LinkedSet[T]: The new set. Never returns nil.
+func NewLinkedSet[T comparable](elems ...T) *LinkedSet[T] {
+	s := new(LinkedSet[T])
+	_ = s.AddMany(elems)
+
+	return s
+}
+
+// Size implements the Set interface.
+func (s *LinkedSet[T]) Size() int {
+	if s == nil {
+		return 0
+	}
+
+	return len(s.index)
+}
+
+// IsEmpty implements the Set interface.
+func (s *LinkedSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Reset implements the Set interface.
+func (s *LinkedSet[T]) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.order.Reset()
+	s.index = nil
+}
+
+// Add implements the Set interface.
+//
+// A new element is added after every other element.
+func (s *LinkedSet[T]) Add(elem T) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	if _, ok := s.index[elem]; ok {
+		return nil
+	}
+
+	if s.index == nil {
+		s.index = make(map[T]*listlike.Element[T])
+	}
+
+	e, _ := s.order.PushBack(elem)
+	s.index[elem] = e
+
+	return nil
+}
+
+// AddMany implements the Set interface.
+//
+// New elements are added after every other element, in order.
+func (s *LinkedSet[T]) AddMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	for _, elem := range elems {
+		_ = s.Add(elem)
+	}
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (s *LinkedSet[T]) Contains(elem T) bool {
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.index[elem]
+	return ok
+}
+
+// Elem implements the Set interface.
+//
+// The elements are iterated in the order in which they were added.
+func (s *LinkedSet[T]) Elem() iter.Seq[T] {
+	if s == nil {
+		return func(yield func(T) bool) {}
+	}
+
+	return s.order.All()
+}
+
+// Backward iterates through the elements of the set from the most recently
+// added to the least recently added.
+//
+// Returns:
+//   - iter.Seq[T]: The elements in the set. Never returns nil.
+func (s *LinkedSet[T]) Backward() iter.Seq[T] {
+	if s == nil {
+		return func(yield func(T) bool) {}
+	}
+
+	return s.order.Backward()
+}
+
+// Remove implements the Set interface.
+func (s *LinkedSet[T]) Remove(elem T) bool {
+	if s == nil {
+		return false
+	}
+
+	e, ok := s.index[elem]
+	if !ok {
+		return false
+	}
+
+	_, _ = s.order.Remove(e)
+	delete(s.index, elem)
+
+	return true
+}
+
+// RemoveMany implements the Set interface.
+func (s *LinkedSet[T]) RemoveMany(elems []T) int {
+	var count int
+
+	for _, elem := range elems {
+		if s.Remove(elem) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is the least recently added one.
+func (s *LinkedSet[T]) Pop() (T, error) {
+	if s == nil || len(s.index) == 0 {
+		return *new(T), ErrEmptySet
+	}
+
+	elem := s.order.Front().Value()
+	s.Remove(elem)
+
+	return elem, nil
+}
+
+// RemoveIf implements the Set interface.
+//
+// The remaining elements keep their order.
+func (s *LinkedSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	var count int
+
+	for e := s.order.Front(); e != nil; {
+		next := e.Next()
+
+		if elem := e.Value(); pred(elem) {
+			s.Remove(elem)
+			count++
+		}
+
+		e = next
+	}
+
+	return count
+}
+
+// Clone implements the Set interface.
+//
+// The copy has the same order as the set.
+func (s *LinkedSet[T]) Clone() Set[T] {
+	c := new(LinkedSet[T])
+
+	if s != nil && len(s.index) > 0 {
+		c.index = make(map[T]*listlike.Element[T], len(s.index))
+
+		for elem := range s.order.All() {
+			e, _ := c.order.PushBack(elem)
+			c.index[elem] = e
+		}
+	}
+
+	return c
+}
+
+// Clear implements the Set interface.
+func (s *LinkedSet[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	s.order.Reset()
+	clear(s.index)
+}
@@ -0,0 +1,38 @@
+package sets
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestLinkedSet tests that LinkedSet keeps the first-insertion order.
+func TestLinkedSet(t *testing.T) {
+	s := NewLinkedSet("c", "a", "b", "a")
+	_ = s.Add("c")
+	_ = s.Add("d")
+
+	if want := []string{"c", "a", "b", "d"}; !slices.Equal(slices.Collect(s.Elem()), want) {
+		t.Errorf("want %v, got %v", want, slices.Collect(s.Elem()))
+	}
+
+	s.Remove("a")
+	_ = s.Add("a")
+
+	s.RemoveIf(func(elem string) bool {
+		return elem == "b"
+	})
+
+	if want := []string{"c", "d", "a"}; !slices.Equal(slices.Collect(s.Elem()), want) {
+		t.Errorf("want %v, got %v", want, slices.Collect(s.Elem()))
+	}
+
+	c := s.Clone()
+	if want := []string{"c", "d", "a"}; !slices.Equal(slices.Collect(c.Elem()), want) {
+		t.Errorf("want %v, got %v", want, slices.Collect(c.Elem()))
+	}
+
+	elem, err := s.Pop()
+	if err != nil || elem != "c" {
+		t.Errorf("want c, got %q (%v)", elem, err)
+	}
+}
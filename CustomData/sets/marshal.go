@@ -0,0 +1,169 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// encodeGob encodes the given value with encoding/gob.
+//
+// Parameters:
+//   - v: The value to encode.
+//
+// Returns:
+//   - []byte: The encoded value.
+//   - error: An error if the value could not be encoded.
+func encodeGob(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decodeGob decodes the given data with encoding/gob.
+//
+// Parameters:
+//   - data: The data to decode.
+//   - v: The value to decode into.
+//
+// Returns:
+//   - error: An error if the data could not be decoded.
+func decodeGob(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The set is encoded as an array of its elements in ascending order.
+func (s OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// The elements do not need to be sorted nor unique.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := json.Unmarshal(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.fromSlice(elems)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s OrderedSet[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(s.toSlice())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *OrderedSet[T]) UnmarshalBinary(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := decodeGob(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.fromSlice(elems)
+
+	return nil
+}
+
+// toSlice returns a copy of the elements of the set.
+func (s OrderedSet[T]) toSlice() []T {
+	elems := slices.Clone(s.elems)
+	if elems == nil {
+		elems = []T{}
+	}
+
+	return elems
+}
+
+// fromSlice replaces the elements of the set with the given elements.
+func (s *OrderedSet[T]) fromSlice(elems []T) {
+	s.Reset()
+
+	if len(elems) == 0 {
+		return
+	}
+
+	slices.Sort(elems)
+	s.elems = slices.Compact(elems)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The set is encoded as an array of its elements in no particular order.
+func (s baseSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *baseSet[T]) UnmarshalJSON(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := json.Unmarshal(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.Reset()
+	_ = s.AddMany(elems)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (s baseSet[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(s.toSlice())
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (s *baseSet[T]) UnmarshalBinary(data []byte) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	}
+
+	var elems []T
+
+	err := decodeGob(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.Reset()
+	_ = s.AddMany(elems)
+
+	return nil
+}
+
+// toSlice returns the elements of the set.
+func (s baseSet[T]) toSlice() []T {
+	elems := make([]T, 0, len(s.elems))
+	return slices.AppendSeq(elems, s.Elem())
+}
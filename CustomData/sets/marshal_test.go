@@ -0,0 +1,41 @@
+package sets
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+// TestMarshal tests JSON and binary round-trips of sets.
+func TestMarshal(t *testing.T) {
+	var s OrderedSet[int]
+
+	err := json.Unmarshal([]byte("[3,1,2,1]"), &s)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	data, _ := json.Marshal(s)
+	if string(data) != "[1,2,3]" {
+		t.Errorf("want %q, got %q", "[1,2,3]", string(data))
+	}
+
+	m := New(1, 2, 3)
+
+	data, err = m.(*baseSet[int]).MarshalBinary()
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	other := New[int]()
+
+	err = other.(*baseSet[int]).UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	elems := slices.Sorted(other.Elem())
+	if !slices.Equal(elems, []int{1, 2, 3}) {
+		t.Errorf("want %v, got %v", []int{1, 2, 3}, elems)
+	}
+}
@@ -0,0 +1,399 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Counted is an element of a multiset with its number of occurrences.
+type Counted[T any] struct {
+	// Elem is the element.
+	Elem T
+
+	// Count is the number of occurrences of the element.
+	Count int
+}
+
+// Multiset is a set in which elements can occur several times. An empty
+// multiset can either be created with the `var set Multiset[T]` syntax or with
+// the `new(Multiset[T])` constructor.
+type Multiset[T comparable] struct {
+	// counts maps every element to its number of occurrences. Never holds a
+	// count that is not positive.
+	counts map[T]int
+
+	// total is the sum of counts.
+	total int
+}
+
+// NewMultiset creates a new multiset from the provided elements. An element that
+// appears several times in elems occurs as many times in the multiset.
+//
+// Parameters:
+//   - elems: The elements to add to the multiset.
+//
+// Returns:
+//   - *Multiset[T]: The new multiset. Never returns nil.
+func NewMultiset[T comparable](elems ...T) *Multiset[T] {
+	m := new(Multiset[T])
+
+	for _, elem := range elems {
+		_ = m.Add(elem, 1)
+	}
+
+	return m
+}
+
+// IsEmpty checks whether the multiset is empty.
+//
+// Returns:
+//   - bool: True if the multiset is empty, false otherwise.
+func (m Multiset[T]) IsEmpty() bool {
+	return m.total == 0
+}
+
+// Reset resets the multiset for reuse.
+func (m *Multiset[T]) Reset() {
+	if m == nil {
+		return
+	}
+
+	if len(m.counts) > 0 {
+		clear(m.counts)
+		m.counts = nil
+	}
+
+	m.total = 0
+}
+
+// Add adds n occurrences of the element to the multiset.
+//
+// Parameters:
+//   - elem: The element to add.
+//   - n: The number of occurrences to add.
+//
+// Returns:
+//   - error: An error if the occurrences could not be added.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If n is negative.
+func (m *Multiset[T]) Add(elem T, n int) error {
+	if m == nil {
+		return common.ErrNilReceiver
+	} else if n < 0 {
+		return common.NewErrBadParam("n", "must be non-negative")
+	} else if n == 0 {
+		return nil
+	}
+
+	if m.counts == nil {
+		m.counts = make(map[T]int)
+	}
+
+	m.counts[elem] += n
+	m.total += n
+
+	return nil
+}
+
+// Remove removes up to n occurrences of the element from the multiset.
+//
+// Parameters:
+//   - elem: The element to remove.
+//   - n: The maximum number of occurrences to remove. If it is not positive,
+//     nothing is removed.
+//
+// Returns:
+//   - int: The number of removed occurrences.
+func (m *Multiset[T]) Remove(elem T, n int) int {
+	if m == nil || n <= 0 {
+		return 0
+	}
+
+	count := m.counts[elem]
+	if count == 0 {
+		return 0
+	}
+
+	removed := min(count, n)
+
+	if removed == count {
+		delete(m.counts, elem)
+	} else {
+		m.counts[elem] = count - removed
+	}
+
+	m.total -= removed
+
+	return removed
+}
+
+// Count returns the number of occurrences of the element.
+//
+// Parameters:
+//   - elem: The element.
+//
+// Returns:
+//   - int: The number of occurrences. Never negative.
+func (m Multiset[T]) Count(elem T) int {
+	return m.counts[elem]
+}
+
+// Distinct returns the number of distinct elements of the multiset.
+//
+// Returns:
+//   - int: The number of distinct elements. Never negative.
+func (m Multiset[T]) Distinct() int {
+	return len(m.counts)
+}
+
+// Total returns the number of occurrences of all the elements of the multiset.
+//
+// Returns:
+//   - int: The total number of occurrences. Never negative.
+func (m Multiset[T]) Total() int {
+	return m.total
+}
+
+// All iterates through the distinct elements of the multiset with their number
+// of occurrences, in no particular order.
+//
+// Returns:
+//   - iter.Seq2[T, int]: The elements and their counts. Never returns nil.
+func (m Multiset[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for elem, count := range m.counts {
+			if !yield(elem, count) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns the k elements with the most occurrences, from the most
+// common to the least common. The order of elements with the same count is not
+// specified.
+//
+// Parameters:
+//   - k: The number of elements to return. If it is not positive or greater than
+//     the number of distinct elements, every element is returned.
+//
+// Returns:
+//   - []Counted[T]: The most common elements with their counts.
+func (m Multiset[T]) MostCommon(k int) []Counted[T] {
+	if len(m.counts) == 0 {
+		return nil
+	}
+
+	res := make([]Counted[T], 0, len(m.counts))
+
+	for elem, count := range m.counts {
+		res = append(res, Counted[T]{Elem: elem, Count: count})
+	}
+
+	slices.SortFunc(res, func(a, b Counted[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	if k > 0 && k < len(res) {
+		res = res[:k:k]
+	}
+
+	return res
+}
+
+// Union makes the count of every element of the multiset the maximum of its
+// count in the multiset and its count in other.
+//
+// Parameters:
+//   - other: The other multiset. Nil is treated as the empty multiset.
+//
+// Returns:
+//   - error: An error if the receiver is nil and other is not empty.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil and other is not empty.
+func (m *Multiset[T]) Union(other *Multiset[T]) error {
+	if other == nil || other.total == 0 {
+		return nil
+	} else if m == nil {
+		return common.ErrNilReceiver
+	}
+
+	for elem, count := range other.counts {
+		if diff := count - m.counts[elem]; diff > 0 {
+			_ = m.Add(elem, diff)
+		}
+	}
+
+	return nil
+}
+
+// Intersect makes the count of every element of the multiset the minimum of its
+// count in the multiset and its count in other.
+//
+// Parameters:
+//   - other: The other multiset. Nil is treated as the empty multiset.
+func (m *Multiset[T]) Intersect(other *Multiset[T]) {
+	if m == nil {
+		return
+	} else if other == nil {
+		m.Reset()
+		return
+	}
+
+	for elem, count := range m.counts {
+		if diff := count - other.counts[elem]; diff > 0 {
+			m.Remove(elem, diff)
+		}
+	}
+}
+
+// Clone returns a copy of the multiset.
+//
+// Returns:
+//   - *Multiset[T]: The copy. Never returns nil.
+func (m Multiset[T]) Clone() *Multiset[T] {
+	return &Multiset[T]{
+		counts: maps.Clone(m.counts),
+		total:  m.total,
+	}
+}
+
+// AsSet returns a view of the distinct elements of the multiset as a set. The
+// view and the multiset share their elements:
+//   - Add adds one occurrence of an element that is not in the multiset.
+//   - Remove, RemoveMany, Pop and RemoveIf remove every occurrence of the
+//     removed elements.
+//   - Clone returns a view of a copy of the multiset.
+//
+// Returns:
+//   - Set[T]: The view. Nil if the receiver is nil.
+func (m *Multiset[T]) AsSet() Set[T] {
+	if m == nil {
+		return nil
+	}
+
+	return multisetView[T]{
+		m: m,
+	}
+}
+
+// multisetView is the view of the distinct elements of a multiset as a set.
+type multisetView[T comparable] struct {
+	// m is the multiset. Never nil.
+	m *Multiset[T]
+}
+
+// Size implements the Set interface.
+func (v multisetView[T]) Size() int {
+	return v.m.Distinct()
+}
+
+// IsEmpty implements the Set interface.
+func (v multisetView[T]) IsEmpty() bool {
+	return v.m.IsEmpty()
+}
+
+// Reset implements the Set interface.
+func (v multisetView[T]) Reset() {
+	v.m.Reset()
+}
+
+// Add implements the Set interface.
+func (v multisetView[T]) Add(elem T) error {
+	if v.m.Count(elem) > 0 {
+		return nil
+	}
+
+	return v.m.Add(elem, 1)
+}
+
+// AddMany implements the Set interface.
+func (v multisetView[T]) AddMany(elems []T) error {
+	for _, elem := range elems {
+		_ = v.Add(elem)
+	}
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (v multisetView[T]) Contains(elem T) bool {
+	return v.m.Count(elem) > 0
+}
+
+// Elem implements the Set interface.
+func (v multisetView[T]) Elem() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for elem := range v.m.counts {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Remove implements the Set interface.
+func (v multisetView[T]) Remove(elem T) bool {
+	return v.m.Remove(elem, v.m.Count(elem)) > 0
+}
+
+// RemoveMany implements the Set interface.
+func (v multisetView[T]) RemoveMany(elems []T) int {
+	var count int
+
+	for _, elem := range elems {
+		if v.Remove(elem) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is an arbitrary one.
+func (v multisetView[T]) Pop() (T, error) {
+	for elem := range v.m.counts {
+		v.Remove(elem)
+		return elem, nil
+	}
+
+	return *new(T), ErrEmptySet
+}
+
+// RemoveIf implements the Set interface.
+func (v multisetView[T]) RemoveIf(pred func(elem T) bool) int {
+	if pred == nil {
+		return 0
+	}
+
+	var count int
+
+	for elem := range v.m.counts {
+		if pred(elem) {
+			v.Remove(elem)
+			count++
+		}
+	}
+
+	return count
+}
+
+// Clone implements the Set interface.
+func (v multisetView[T]) Clone() Set[T] {
+	return v.m.Clone().AsSet()
+}
+
+// Clear implements the Set interface.
+func (v multisetView[T]) Clear() {
+	clear(v.m.counts)
+	v.m.total = 0
+}
@@ -0,0 +1,43 @@
+package sets
+
+import (
+	"testing"
+)
+
+// TestMultiset tests counts, MostCommon, Union and Intersect.
+func TestMultiset(t *testing.T) {
+	m := NewMultiset("a", "b", "a", "c", "a", "b")
+
+	if m.Count("a") != 3 || m.Distinct() != 3 || m.Total() != 6 {
+		t.Errorf("want 3 a's, 3 distinct and 6 in total, got %d, %d and %d", m.Count("a"), m.Distinct(), m.Total())
+	}
+
+	err := m.Add("d", -1)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	top := m.MostCommon(2)
+	if len(top) != 2 || top[0] != (Counted[string]{"a", 3}) || top[1] != (Counted[string]{"b", 2}) {
+		t.Errorf("want [{a 3} {b 2}], got %v", top)
+	}
+
+	if got := m.Remove("b", 5); got != 2 || m.Count("b") != 0 || m.Distinct() != 2 {
+		t.Errorf("want 2 removed b's, got %d", got)
+	}
+
+	other := NewMultiset("a", "c", "c", "e")
+
+	u := m.Clone()
+	_ = u.Union(other)
+
+	if u.Count("a") != 3 || u.Count("c") != 2 || u.Count("e") != 1 || u.Total() != 6 {
+		t.Errorf("want max counts, got a=%d c=%d e=%d", u.Count("a"), u.Count("c"), u.Count("e"))
+	}
+
+	m.Intersect(other)
+
+	if m.Count("a") != 1 || m.Count("c") != 1 || m.Count("e") != 0 || m.Total() != 2 {
+		t.Errorf("want min counts, got a=%d c=%d e=%d", m.Count("a"), m.Count("c"), m.Count("e"))
+	}
+}
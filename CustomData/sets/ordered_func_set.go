@@ -0,0 +1,385 @@
+package sets
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// OrderedFuncSet is a set in ascending order according to a comparison function.
+// Two elements are the same element of the set if the comparison function
+// returns 0 for them. An OrderedFuncSet must be created with NewOrderedFuncSet.
+type OrderedFuncSet[T any] struct {
+	// elems are the elements of the set, in ascending order.
+	elems []T
+
+	// compare is the comparison function of the set.
+	compare func(a, b T) int
+}
+
+// sortFunc sorts the given elements according to the comparison function and
+// keeps the first of equal elements.
+//
+// Parameters:
+//   - elems: The elements to sort. They are modified.
+//   - compare: The comparison function.
+//
+// Returns:
+//   - []T: The sorted elements without duplicates.
+func sortFunc[T any](elems []T, compare func(a, b T) int) []T {
+	slices.SortStableFunc(elems, compare)
+
+	return slices.CompactFunc(elems, func(a, b T) bool {
+		return compare(a, b) == 0
+	})
+}
+
+// NewOrderedFuncSet creates a new ordered set from the provided elements. When
+// several elements compare equal, only the first one is kept.
+//
+// Parameters:
+//   - compare: The comparison function. It must return a negative number when
+//     a < b, a positive number when a > b and 0 when a == b.
+//   - elems: The elements to initialize the set with. The slice is not retained.
+//
+// Returns:
+//   - *OrderedFuncSet[T]: The new set. Nil if an error occurred.
+//   - error: An error if the set could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If compare is nil.
+func NewOrderedFuncSet[T any](compare func(a, b T) int, elems []T) (*OrderedFuncSet[T], error) {
+	if compare == nil {
+		return nil, common.NewErrNilParam("compare")
+	}
+
+	return &OrderedFuncSet[T]{
+		elems:   sortFunc(slices.Clone(elems), compare),
+		compare: compare,
+	}, nil
+}
+
+// search returns the position of the element in the set and whether it is
+// present.
+func (s OrderedFuncSet[T]) search(elem T) (int, bool) {
+	return slices.BinarySearchFunc(s.elems, elem, s.compare)
+}
+
+// Size implements the Set interface.
+func (s OrderedFuncSet[T]) Size() int {
+	return len(s.elems)
+}
+
+// IsEmpty implements the Set interface.
+func (s OrderedFuncSet[T]) IsEmpty() bool {
+	return len(s.elems) == 0
+}
+
+// Reset implements the Set interface.
+//
+// The comparison function is kept.
+func (s *OrderedFuncSet[T]) Reset() {
+	if s == nil {
+		return
+	}
+
+	if len(s.elems) > 0 {
+		clear(s.elems)
+		s.elems = nil
+	}
+}
+
+// Add implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewOrderedFuncSet.
+func (s *OrderedFuncSet[T]) Add(elem T) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if s.compare == nil {
+		return common.NewErrNilParam("compare")
+	}
+
+	pos, ok := s.search(elem)
+	if !ok {
+		s.elems = slices.Insert(s.elems, pos, elem)
+	}
+
+	return nil
+}
+
+// AddMany implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewOrderedFuncSet.
+func (s *OrderedFuncSet[T]) AddMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	} else if s.compare == nil {
+		return common.NewErrNilParam("compare")
+	}
+
+	added := sortFunc(slices.Clone(elems), s.compare)
+	s.elems = merge(s.elems, added, s.compare, true, true, true)
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (s OrderedFuncSet[T]) Contains(elem T) bool {
+	if len(s.elems) == 0 {
+		return false
+	}
+
+	_, ok := s.search(elem)
+	return ok
+}
+
+// Elem implements the Set interface.
+//
+// The elements are iterated in ascending order.
+func (s OrderedFuncSet[T]) Elem() iter.Seq[T] {
+	return seqOf(s.elems)
+}
+
+// Remove implements the Set interface.
+func (s *OrderedFuncSet[T]) Remove(elem T) bool {
+	if s == nil || len(s.elems) == 0 {
+		return false
+	}
+
+	pos, ok := s.search(elem)
+	if ok {
+		s.elems = slices.Delete(s.elems, pos, pos+1)
+	}
+
+	return ok
+}
+
+// RemoveMany implements the Set interface.
+func (s *OrderedFuncSet[T]) RemoveMany(elems []T) int {
+	if s == nil || len(s.elems) == 0 || len(elems) == 0 {
+		return 0
+	}
+
+	before := len(s.elems)
+
+	if len(elems) == 1 {
+		s.Remove(elems[0])
+	} else {
+		removed := sortFunc(slices.Clone(elems), s.compare)
+		s.elems = merge(s.elems, removed, s.compare, true, false, false)
+	}
+
+	return before - len(s.elems)
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is the greatest one.
+func (s *OrderedFuncSet[T]) Pop() (T, error) {
+	if s == nil || len(s.elems) == 0 {
+		return *new(T), ErrEmptySet
+	}
+
+	last := len(s.elems) - 1
+
+	elem := s.elems[last]
+	s.elems[last] = *new(T)
+	s.elems = s.elems[:last]
+
+	return elem, nil
+}
+
+// RemoveIf implements the Set interface.
+func (s *OrderedFuncSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	before := len(s.elems)
+
+	s.elems = slices.DeleteFunc(s.elems, pred)
+
+	return before - len(s.elems)
+}
+
+// Clone implements the Set interface.
+func (s OrderedFuncSet[T]) Clone() Set[T] {
+	return &OrderedFuncSet[T]{
+		elems:   slices.Clone(s.elems),
+		compare: s.compare,
+	}
+}
+
+// Clear implements the Set interface.
+func (s *OrderedFuncSet[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	clear(s.elems)
+	s.elems = s.elems[:0]
+}
+
+// sortedOf returns the elements of the given set sorted by the comparison
+// function of the receiver and without duplicates.
+func (s OrderedFuncSet[T]) sortedOf(other Set[T]) []T {
+	return sortFunc(slices.Collect(other.Elem()), s.compare)
+}
+
+// withElems returns a new set with the comparison function of the receiver.
+func (s OrderedFuncSet[T]) withElems(elems []T) Set[T] {
+	return &OrderedFuncSet[T]{
+		elems:   elems,
+		compare: s.compare,
+	}
+}
+
+// union implements the algebra interface.
+func (s OrderedFuncSet[T]) union(other Set[T]) (Set[T], error) {
+	if s.compare == nil {
+		return cloneAlgebra[T]{set: &s}.union(other)
+	}
+
+	return s.withElems(merge(s.elems, s.sortedOf(other), s.compare, true, true, true)), nil
+}
+
+// intersection implements the algebra interface.
+func (s OrderedFuncSet[T]) intersection(other Set[T]) Set[T] {
+	if s.compare == nil {
+		return s.withElems(nil)
+	}
+
+	return s.withElems(merge(s.elems, s.sortedOf(other), s.compare, false, true, false))
+}
+
+// difference implements the algebra interface.
+func (s OrderedFuncSet[T]) difference(other Set[T]) Set[T] {
+	if s.compare == nil {
+		return s.withElems(nil)
+	}
+
+	return s.withElems(merge(s.elems, s.sortedOf(other), s.compare, true, false, false))
+}
+
+// symmetricDifference implements the algebra interface.
+func (s OrderedFuncSet[T]) symmetricDifference(other Set[T]) (Set[T], error) {
+	if s.compare == nil {
+		return cloneAlgebra[T]{set: &s}.symmetricDifference(other)
+	}
+
+	return s.withElems(merge(s.elems, s.sortedOf(other), s.compare, true, false, true)), nil
+}
+
+// Min returns the smallest element of the set.
+//
+// Returns:
+//   - T: The smallest element.
+//   - error: An error if the set is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the set is empty.
+func (s OrderedFuncSet[T]) Min() (T, error) {
+	return sortedMin(s.elems)
+}
+
+// Max returns the greatest element of the set.
+//
+// Returns:
+//   - T: The greatest element.
+//   - error: An error if the set is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the set is empty.
+func (s OrderedFuncSet[T]) Max() (T, error) {
+	return sortedMax(s.elems)
+}
+
+// Floor returns the greatest element of the set that is less than or equal to
+// the given element.
+//
+// Parameters:
+//   - elem: The element to compare with.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func (s OrderedFuncSet[T]) Floor(elem T) (T, bool) {
+	if len(s.elems) == 0 {
+		return *new(T), false
+	}
+
+	return sortedFloor(s.elems, elem, s.compare)
+}
+
+// Ceiling returns the smallest element of the set that is greater than or equal
+// to the given element.
+//
+// Parameters:
+//   - elem: The element to compare with.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func (s OrderedFuncSet[T]) Ceiling(elem T) (T, bool) {
+	if len(s.elems) == 0 {
+		return *new(T), false
+	}
+
+	return sortedCeiling(s.elems, elem, s.compare)
+}
+
+// Range iterates, in ascending order, through the elements of the set that are
+// greater than or equal to lo and less than hi.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - iter.Seq[T]: The elements in range. Never returns nil.
+func (s OrderedFuncSet[T]) Range(lo, hi T) iter.Seq[T] {
+	if len(s.elems) == 0 {
+		return seqOf[T](nil)
+	}
+
+	return seqOf(sortedRange(s.elems, lo, hi, s.compare))
+}
+
+// Rank returns the number of elements of the set that are less than the given
+// element; that is, the index the element has or would have in the set.
+//
+// Parameters:
+//   - elem: The element to rank.
+//
+// Returns:
+//   - int: The rank of the element. Never negative.
+func (s OrderedFuncSet[T]) Rank(elem T) int {
+	if len(s.elems) == 0 {
+		return 0
+	}
+
+	pos, _ := s.search(elem)
+	return pos
+}
+
+// At returns the element of the set at the given index, in ascending order.
+//
+// Parameters:
+//   - i: The index of the element.
+//
+// Returns:
+//   - T: The element at index i.
+//   - error: An error if i is out of bounds.
+//
+// Errors:
+//   - ErrOutOfBounds: If i is not in [0, Size()).
+func (s OrderedFuncSet[T]) At(i int) (T, error) {
+	return sortedAt(s.elems, i)
+}
@@ -0,0 +1,152 @@
+package sets
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+)
+
+// TestOrderedFuncSet tests an ordered set of structs.
+func TestOrderedFuncSet(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	byAge := func(a, b Person) int {
+		return cmp.Compare(a.Age, b.Age)
+	}
+
+	_, err := NewOrderedFuncSet[Person](nil, nil)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	s, err := NewOrderedFuncSet(byAge, []Person{{"b", 30}, {"a", 20}, {"c", 30}})
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	if s.Size() != 2 {
+		t.Errorf("want size 2, got %d", s.Size())
+	}
+
+	_ = s.AddMany([]Person{{"d", 10}, {"e", 20}})
+
+	names := make([]string, 0, s.Size())
+	for p := range s.Elem() {
+		names = append(names, p.Name)
+	}
+
+	if want := []string{"d", "a", "b"}; !slices.Equal(names, want) {
+		t.Errorf("want %v, got %v", want, names)
+	}
+
+	if !s.Contains(Person{Age: 20}) || s.Rank(Person{Age: 25}) != 2 {
+		t.Errorf("want lookups by age")
+	}
+}
+
+// TestOrderedFuncSet_Zero tests that the algebra of a set that was not created
+// with NewOrderedFuncSet reports the elements it cannot hold.
+func TestOrderedFuncSet_Zero(t *testing.T) {
+	var zero OrderedFuncSet[int]
+
+	other := New(1, 2)
+
+	_, err := Union[int](&zero, other)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	_, err = SymmetricDifference[int](&zero, other)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+
+	res, err := Union[int](&zero, nil)
+	if err != nil || !res.IsEmpty() {
+		t.Errorf("want an empty union, got %v", err)
+	}
+
+	err = Merge[int](&zero, other)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+}
+
+// TestNavigation tests the navigation queries of both ordered sets.
+func TestNavigation(t *testing.T) {
+	type Navigable interface {
+		Set[int]
+		Min() (int, error)
+		Max() (int, error)
+		Floor(elem int) (int, bool)
+		Ceiling(elem int) (int, bool)
+		Range(lo, hi int) iter.Seq[int]
+		Rank(elem int) int
+		At(i int) (int, error)
+	}
+
+	func_set, _ := NewOrderedFuncSet(cmp.Compare[int], nil)
+
+	kinds := map[string]Navigable{
+		"OrderedSet":     NewOrderedSet[int](nil),
+		"OrderedFuncSet": func_set,
+	}
+
+	for name, s := range kinds {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.Min()
+			if !errors.Is(err, ErrEmptySet) {
+				t.Errorf("want %v, got %v", ErrEmptySet, err)
+			}
+
+			_ = s.AddMany([]int{50, 10, 30, 40, 20})
+
+			if v, _ := s.Min(); v != 10 {
+				t.Errorf("want min 10, got %d", v)
+			}
+
+			if v, _ := s.Max(); v != 50 {
+				t.Errorf("want max 50, got %d", v)
+			}
+
+			if v, ok := s.Floor(35); !ok || v != 30 {
+				t.Errorf("want floor 30, got %d (%t)", v, ok)
+			}
+
+			if _, ok := s.Floor(5); ok {
+				t.Errorf("want no floor of 5")
+			}
+
+			if v, ok := s.Ceiling(30); !ok || v != 30 {
+				t.Errorf("want ceiling 30, got %d (%t)", v, ok)
+			}
+
+			if _, ok := s.Ceiling(55); ok {
+				t.Errorf("want no ceiling of 55")
+			}
+
+			got := slices.Collect(s.Range(20, 40))
+			if want := []int{20, 30}; !slices.Equal(got, want) {
+				t.Errorf("want %v, got %v", want, got)
+			}
+
+			if r := s.Rank(30); r != 2 {
+				t.Errorf("want rank 2, got %d", r)
+			}
+
+			if v, _ := s.At(3); v != 40 {
+				t.Errorf("want 40, got %d", v)
+			}
+
+			_, err = s.At(5)
+			if !errors.Is(err, ErrOutOfBounds) {
+				t.Errorf("want %v, got %v", ErrOutOfBounds, err)
+			}
+		})
+	}
+}
@@ -94,6 +94,87 @@ func (s OrderedSet[T]) Elem() iter.Seq[T] {
 	}
 }
 
+// Remove implements the Set interface.
+func (s *OrderedSet[T]) Remove(elem T) bool {
+	if s == nil {
+		return false
+	}
+
+	pos, ok := slices.BinarySearch(s.elems, elem)
+	if ok {
+		s.elems = slices.Delete(s.elems, pos, pos+1)
+	}
+
+	return ok
+}
+
+// RemoveMany implements the Set interface.
+func (s *OrderedSet[T]) RemoveMany(elems []T) int {
+	if s == nil || len(s.elems) == 0 || len(elems) == 0 {
+		return 0
+	}
+
+	before := len(s.elems)
+
+	if len(elems) == 1 {
+		s.Remove(elems[0])
+	} else {
+		removed := slices.Clone(elems)
+		slices.Sort(removed)
+
+		s.elems = merge(s.elems, slices.Compact(removed), cmp.Compare[T], true, false, false)
+	}
+
+	return before - len(s.elems)
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is the greatest one.
+func (s *OrderedSet[T]) Pop() (T, error) {
+	if s == nil || len(s.elems) == 0 {
+		return *new(T), ErrEmptySet
+	}
+
+	last := len(s.elems) - 1
+
+	elem := s.elems[last]
+	s.elems[last] = *new(T)
+	s.elems = s.elems[:last]
+
+	return elem, nil
+}
+
+// RemoveIf implements the Set interface.
+func (s *OrderedSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	before := len(s.elems)
+
+	s.elems = slices.DeleteFunc(s.elems, pred)
+
+	return before - len(s.elems)
+}
+
+// Clone implements the Set interface.
+func (s OrderedSet[T]) Clone() Set[T] {
+	return &OrderedSet[T]{
+		elems: slices.Clone(s.elems),
+	}
+}
+
+// Clear implements the Set interface.
+func (s *OrderedSet[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	clear(s.elems)
+	s.elems = s.elems[:0]
+}
+
 // NewOrderedSet creates a new ordered set from the provided elements.
 // The set will contain unique elements in ascending order.
 //
@@ -109,16 +190,102 @@ func NewOrderedSet[T cmp.Ordered](elems []T) *OrderedSet[T] {
 		}
 	}
 
-	unique := make([]T, 0, len(elems))
-
-	for _, elem := range elems {
-		pos, ok := slices.BinarySearch(unique, elem)
-		if !ok {
-			unique = slices.Insert(unique, pos, elem)
-		}
-	}
+	unique := slices.Clone(elems)
+	slices.Sort(unique)
+	unique = slices.Compact(unique)
 
 	return &OrderedSet[T]{
 		elems: unique[:len(unique):len(unique)],
 	}
 }
+
+// Min returns the smallest element of the set.
+//
+// Returns:
+//   - T: The smallest element.
+//   - error: An error if the set is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the set is empty.
+func (s OrderedSet[T]) Min() (T, error) {
+	return sortedMin(s.elems)
+}
+
+// Max returns the greatest element of the set.
+//
+// Returns:
+//   - T: The greatest element.
+//   - error: An error if the set is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the set is empty.
+func (s OrderedSet[T]) Max() (T, error) {
+	return sortedMax(s.elems)
+}
+
+// Floor returns the greatest element of the set that is less than or equal to
+// the given element.
+//
+// Parameters:
+//   - elem: The element to compare with.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func (s OrderedSet[T]) Floor(elem T) (T, bool) {
+	return sortedFloor(s.elems, elem, cmp.Compare[T])
+}
+
+// Ceiling returns the smallest element of the set that is greater than or equal
+// to the given element.
+//
+// Parameters:
+//   - elem: The element to compare with.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func (s OrderedSet[T]) Ceiling(elem T) (T, bool) {
+	return sortedCeiling(s.elems, elem, cmp.Compare[T])
+}
+
+// Range iterates, in ascending order, through the elements of the set that are
+// greater than or equal to lo and less than hi.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - iter.Seq[T]: The elements in range. Never returns nil.
+func (s OrderedSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return seqOf(sortedRange(s.elems, lo, hi, cmp.Compare[T]))
+}
+
+// Rank returns the number of elements of the set that are less than the given
+// element; that is, the index the element has or would have in the set.
+//
+// Parameters:
+//   - elem: The element to rank.
+//
+// Returns:
+//   - int: The rank of the element. Never negative.
+func (s OrderedSet[T]) Rank(elem T) int {
+	pos, _ := slices.BinarySearch(s.elems, elem)
+	return pos
+}
+
+// At returns the element of the set at the given index, in ascending order.
+//
+// Parameters:
+//   - i: The index of the element.
+//
+// Returns:
+//   - T: The element at index i.
+//   - error: An error if i is out of bounds.
+//
+// Errors:
+//   - ErrOutOfBounds: If i is not in [0, Size()).
+func (s OrderedSet[T]) At(i int) (T, error) {
+	return sortedAt(s.elems, i)
+}
@@ -58,6 +58,70 @@ type Set[T any] interface {
 	// Returns:
 	//   - iter.Seq[T]: The elements in the set. Never returns nil.
 	Elem() iter.Seq[T]
+
+	// Remove removes an element from the set if it is present.
+	//
+	// Parameters:
+	//   - elem: The element to remove from the set.
+	//
+	// Returns:
+	//   - bool: True if the element was present, false otherwise.
+	Remove(elem T) bool
+
+	// RemoveMany removes multiple elements from the set. It must be equal to calling
+	// Remove multiple times but can be more efficient than removing each element
+	// individually.
+	//
+	// Parameters:
+	//   - elems: The elements to remove from the set.
+	//
+	// Returns:
+	//   - int: The number of elements that were present and removed.
+	RemoveMany(elems []T) int
+
+	// Pop removes an element from the set and returns it. Which element is removed
+	// depends on the implementation.
+	//
+	// Returns:
+	//   - T: The removed element.
+	//   - error: An error if the set is empty.
+	//
+	// Errors:
+	//   - ErrEmptySet: If the set is empty.
+	Pop() (T, error)
+
+	// RemoveIf removes every element of the set that satisfies the predicate.
+	//
+	// Parameters:
+	//   - pred: The predicate. If nil, no element is removed.
+	//
+	// Returns:
+	//   - int: The number of removed elements.
+	RemoveIf(pred func(elem T) bool) int
+
+	// Clone returns a shallow copy of the set, of the same concrete kind.
+	//
+	// Returns:
+	//   - Set[T]: The copy. Never returns nil.
+	Clone() Set[T]
+
+	// Clear removes every element of the set. Unlike Reset, the memory of the set
+	// is kept for reuse.
+	Clear()
+}
+
+// merger is implemented by sets that can merge some kinds of sets faster than
+// by adding their elements one by one.
+type merger[T any] interface {
+	// mergeFrom adds every element of other to the receiver.
+	//
+	// Parameters:
+	//   - other: The set to merge. Never nil.
+	//
+	// Returns:
+	//   - bool: False if the fast path does not apply to other; in which case,
+	//     the receiver is left unchanged.
+	mergeFrom(other Set[T]) bool
 }
 
 // Merge merges the elements of another set into the specified set. The elements will
@@ -72,11 +136,16 @@ type Set[T any] interface {
 //
 // Errors:
 //   - common.ErrBadParam: If other has at least one element and from is nil.
+//   - any error returned by from.AddMany.
 func Merge[T any](from, other Set[T]) error {
 	if other == nil {
 		return nil
 	}
 
+	if m, ok := from.(merger[T]); ok && m.mergeFrom(other) {
+		return nil
+	}
+
 	slice := slices.Collect(other.Elem())
 	if len(slice) == 0 {
 		return nil
@@ -84,9 +153,7 @@ func Merge[T any](from, other Set[T]) error {
 		return common.NewErrNilParam("from")
 	}
 
-	_ = from.AddMany(slice)
-
-	return nil
+	return from.AddMany(slice)
 }
 
 // baseSet is the base implementation of the Set interface.
@@ -170,6 +237,90 @@ func (s baseSet[T]) Elem() iter.Seq[T] {
 	}
 }
 
+// Remove implements the Set interface.
+func (s *baseSet[T]) Remove(elem T) bool {
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.elems[elem]
+	if ok {
+		delete(s.elems, elem)
+	}
+
+	return ok
+}
+
+// RemoveMany implements the Set interface.
+func (s *baseSet[T]) RemoveMany(elems []T) int {
+	if s == nil {
+		return 0
+	}
+
+	var count int
+
+	for _, elem := range elems {
+		if _, ok := s.elems[elem]; ok {
+			delete(s.elems, elem)
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is an arbitrary one.
+func (s *baseSet[T]) Pop() (T, error) {
+	if s == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	for elem := range s.elems {
+		delete(s.elems, elem)
+		return elem, nil
+	}
+
+	return *new(T), ErrEmptySet
+}
+
+// RemoveIf implements the Set interface.
+func (s *baseSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	var count int
+
+	for elem := range s.elems {
+		if pred(elem) {
+			delete(s.elems, elem)
+			count++
+		}
+	}
+
+	return count
+}
+
+// Clone implements the Set interface.
+func (s baseSet[T]) Clone() Set[T] {
+	if len(s.elems) == 0 {
+		return &baseSet[T]{}
+	}
+
+	return s.clone(len(s.elems))
+}
+
+// Clear implements the Set interface.
+func (s *baseSet[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	clear(s.elems)
+}
+
 // New creates a new set of comparable elements from the provided elements.
 //
 // Parameters:
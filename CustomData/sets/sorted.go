@@ -0,0 +1,197 @@
+package sets
+
+import (
+	"iter"
+	"slices"
+)
+
+// merge walks two sorted slices at once and keeps the elements selected by the
+// given flags.
+//
+// Parameters:
+//   - x: The first sorted slice.
+//   - y: The second sorted slice.
+//   - compare: The comparison function both slices are sorted by.
+//   - only_x: Whether to keep the elements that are only in x.
+//   - both: Whether to keep the elements that are in both x and y.
+//   - only_y: Whether to keep the elements that are only in y.
+//
+// Returns:
+//   - []T: The kept elements, sorted.
+func merge[T any](x, y []T, compare func(a, b T) int, only_x, both, only_y bool) []T {
+	var res []T
+
+	var i, j int
+
+	for i < len(x) && j < len(y) {
+		switch c := compare(x[i], y[j]); {
+		case c < 0:
+			if only_x {
+				res = append(res, x[i])
+			}
+
+			i++
+		case c > 0:
+			if only_y {
+				res = append(res, y[j])
+			}
+
+			j++
+		default:
+			if both {
+				res = append(res, x[i])
+			}
+
+			i++
+			j++
+		}
+	}
+
+	if only_x {
+		res = append(res, x[i:]...)
+	}
+
+	if only_y {
+		res = append(res, y[j:]...)
+	}
+
+	return res
+}
+
+// sortedMin returns the first element of a sorted slice.
+//
+// Parameters:
+//   - elems: The sorted slice.
+//
+// Returns:
+//   - T: The smallest element.
+//   - error: An error if the slice is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the slice is empty.
+func sortedMin[T any](elems []T) (T, error) {
+	if len(elems) == 0 {
+		return *new(T), ErrEmptySet
+	}
+
+	return elems[0], nil
+}
+
+// sortedMax returns the last element of a sorted slice.
+//
+// Parameters:
+//   - elems: The sorted slice.
+//
+// Returns:
+//   - T: The greatest element.
+//   - error: An error if the slice is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the slice is empty.
+func sortedMax[T any](elems []T) (T, error) {
+	if len(elems) == 0 {
+		return *new(T), ErrEmptySet
+	}
+
+	return elems[len(elems)-1], nil
+}
+
+// sortedFloor returns the greatest element of a sorted slice that is less than
+// or equal to the given element.
+//
+// Parameters:
+//   - elems: The sorted slice.
+//   - elem: The element to compare with.
+//   - compare: The comparison function the slice is sorted by.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func sortedFloor[T any](elems []T, elem T, compare func(a, b T) int) (T, bool) {
+	pos, ok := slices.BinarySearchFunc(elems, elem, compare)
+	if ok {
+		return elems[pos], true
+	} else if pos == 0 {
+		return *new(T), false
+	}
+
+	return elems[pos-1], true
+}
+
+// sortedCeiling returns the smallest element of a sorted slice that is greater
+// than or equal to the given element.
+//
+// Parameters:
+//   - elems: The sorted slice.
+//   - elem: The element to compare with.
+//   - compare: The comparison function the slice is sorted by.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func sortedCeiling[T any](elems []T, elem T, compare func(a, b T) int) (T, bool) {
+	pos, _ := slices.BinarySearchFunc(elems, elem, compare)
+	if pos == len(elems) {
+		return *new(T), false
+	}
+
+	return elems[pos], true
+}
+
+// sortedRange returns the elements of a sorted slice that are in [lo, hi).
+//
+// Parameters:
+//   - elems: The sorted slice.
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//   - compare: The comparison function the slice is sorted by.
+//
+// Returns:
+//   - []T: The elements in range. It shares memory with elems.
+func sortedRange[T any](elems []T, lo, hi T, compare func(a, b T) int) []T {
+	start, _ := slices.BinarySearchFunc(elems, lo, compare)
+	end, _ := slices.BinarySearchFunc(elems, hi, compare)
+
+	if start >= end {
+		return nil
+	}
+
+	return elems[start:end]
+}
+
+// sortedAt returns the element of a sorted slice at the given index.
+//
+// Parameters:
+//   - elems: The sorted slice.
+//   - i: The index.
+//
+// Returns:
+//   - T: The element at index i.
+//   - error: An error if i is out of bounds.
+//
+// Errors:
+//   - ErrOutOfBounds: If i is not in [0, len(elems)).
+func sortedAt[T any](elems []T, i int) (T, error) {
+	if i < 0 || i >= len(elems) {
+		return *new(T), ErrOutOfBounds
+	}
+
+	return elems[i], nil
+}
+
+// seqOf returns an iterator over the given slice.
+//
+// Parameters:
+//   - elems: The slice.
+//
+// Returns:
+//   - iter.Seq[T]: The elements of the slice, in order. Never returns nil.
+func seqOf[T any](elems []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range elems {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,610 @@
+package sets
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// Sync is a set that is safe for concurrent use. It wraps another set and
+// guards every operation with a read-write mutex. The wrapped set must not be
+// used directly anymore. A Sync must be created with NewSync; the zero value
+// behaves as an empty set that cannot be added to.
+type Sync[T any] struct {
+	// mu guards set.
+	mu sync.RWMutex
+
+	// set is the wrapped set.
+	set Set[T]
+}
+
+// NewSync wraps the given set to make it safe for concurrent use.
+//
+// Size, IsEmpty, Contains, Elem and Clone run under the read lock, so several
+// of them may call the wrapped set at the same time: these methods of the
+// wrapped set must not modify it, not even to cache a result. Every set of this
+// package meets this requirement.
+//
+// Parameters:
+//   - set: The set to wrap.
+//
+// Returns:
+//   - *Sync[T]: The new set. Nil if an error occurred.
+//   - error: An error if the set could not be wrapped.
+//
+// Errors:
+//   - common.ErrBadParam: If set is nil.
+func NewSync[T any](set Set[T]) (*Sync[T], error) {
+	if set == nil {
+		return nil, common.NewErrNilParam("set")
+	}
+
+	return &Sync[T]{
+		set: set,
+	}, nil
+}
+
+// Do calls fn with the wrapped set while holding the write lock; thus, the
+// operations done by fn are atomic with respect to every other operation on s.
+// fn must not use s nor keep the set after it returns.
+//
+// Parameters:
+//   - fn: The function to call.
+//
+// Returns:
+//   - error: The error returned by fn.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewSync or fn is nil.
+//   - any error returned by fn.
+func (s *Sync[T]) Do(fn func(set Set[T]) error) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if s.set == nil {
+		return common.NewErrNilParam("set")
+	} else if fn == nil {
+		return common.NewErrNilParam("fn")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return fn(s.set)
+}
+
+// Size implements the Set interface.
+func (s *Sync[T]) Size() int {
+	if s == nil || s.set == nil {
+		return 0
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Size()
+}
+
+// IsEmpty implements the Set interface.
+func (s *Sync[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Reset implements the Set interface.
+func (s *Sync[T]) Reset() {
+	if s == nil || s.set == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Reset()
+}
+
+// Add implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewSync.
+//   - any error returned by the wrapped set.
+func (s *Sync[T]) Add(elem T) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if s.set == nil {
+		return common.NewErrNilParam("set")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Add(elem)
+}
+
+// AddMany implements the Set interface.
+//
+// The elements are added atomically.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewSync.
+//   - any error returned by the wrapped set.
+func (s *Sync[T]) AddMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	} else if s.set == nil {
+		return common.NewErrNilParam("set")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.AddMany(elems)
+}
+
+// Contains implements the Set interface.
+func (s *Sync[T]) Contains(elem T) bool {
+	if s == nil || s.set == nil {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Contains(elem)
+}
+
+// Elem implements the Set interface.
+//
+// The elements are copied under the read lock when the iteration starts; thus,
+// the iteration sees a consistent snapshot of the set, and the set can be
+// modified during the iteration.
+func (s *Sync[T]) Elem() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil || s.set == nil {
+			return
+		}
+
+		s.mu.RLock()
+		snapshot := slices.Collect(s.set.Elem())
+		s.mu.RUnlock()
+
+		for _, elem := range snapshot {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Remove implements the Set interface.
+func (s *Sync[T]) Remove(elem T) bool {
+	if s == nil || s.set == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Remove(elem)
+}
+
+// RemoveMany implements the Set interface.
+//
+// The elements are removed atomically.
+func (s *Sync[T]) RemoveMany(elems []T) int {
+	if s == nil || s.set == nil || len(elems) == 0 {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.RemoveMany(elems)
+}
+
+// Pop implements the Set interface.
+func (s *Sync[T]) Pop() (T, error) {
+	if s == nil || s.set == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Pop()
+}
+
+// RemoveIf implements the Set interface.
+//
+// The predicate is called while holding the write lock; thus, it must not use
+// the set.
+func (s *Sync[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || s.set == nil || pred == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.RemoveIf(pred)
+}
+
+// Clone implements the Set interface.
+//
+// The copy is a new Sync that wraps a copy of the wrapped set.
+func (s *Sync[T]) Clone() Set[T] {
+	if s == nil {
+		return nil
+	} else if s.set == nil {
+		return &Sync[T]{}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &Sync[T]{
+		set: s.set.Clone(),
+	}
+}
+
+// Clear implements the Set interface.
+func (s *Sync[T]) Clear() {
+	if s == nil || s.set == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Clear()
+}
+
+// shard is a part of a ShardedSet.
+type shard[T comparable] struct {
+	// mu guards elems.
+	mu sync.RWMutex
+
+	// elems are the elements of the shard.
+	elems map[T]struct{}
+}
+
+// ShardedSet is a set of comparable elements that is safe for concurrent use
+// and suited to high contention: its elements are spread across shards, each
+// guarded by its own read-write mutex, so that operations on elements of
+// different shards do not wait for each other. A ShardedSet must be created
+// with NewShardedSet.
+type ShardedSet[T comparable] struct {
+	// shards are the shards of the set.
+	shards []shard[T]
+
+	// hash chooses the shard of every element.
+	hash Hasher[T]
+
+	// size is the number of elements of the set.
+	size atomic.Int64
+}
+
+// NewShardedSet creates a new, empty sharded set.
+//
+// Parameters:
+//   - shards: The number of shards. A good value is a few times the number of
+//     goroutines that use the set at the same time.
+//   - hash: The function that chooses the shard of every element; for instance,
+//     HashString[string].
+//
+// Returns:
+//   - *ShardedSet[T]: The new set. Nil if an error occurred.
+//   - error: An error if the set could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If shards is not positive or hash is nil.
+func NewShardedSet[T comparable](shards int, hash Hasher[T]) (*ShardedSet[T], error) {
+	if shards <= 0 {
+		return nil, common.NewErrBadParam("shards", "must be positive")
+	} else if hash == nil {
+		return nil, common.NewErrNilParam("hash")
+	}
+
+	return &ShardedSet[T]{
+		shards: make([]shard[T], shards),
+		hash:   hash,
+	}, nil
+}
+
+// shardOf returns the shard of the element.
+func (s *ShardedSet[T]) shardOf(elem T) *shard[T] {
+	return &s.shards[s.hash(elem)%uint64(len(s.shards))]
+}
+
+// lockAll acquires the lock of every shard, always in the same order.
+func (s *ShardedSet[T]) lockAll(write bool) {
+	for i := range s.shards {
+		if write {
+			s.shards[i].mu.Lock()
+		} else {
+			s.shards[i].mu.RLock()
+		}
+	}
+}
+
+// unlockAll releases the lock of every shard.
+func (s *ShardedSet[T]) unlockAll(write bool) {
+	for i := range s.shards {
+		if write {
+			s.shards[i].mu.Unlock()
+		} else {
+			s.shards[i].mu.RUnlock()
+		}
+	}
+}
+
+// Size implements the Set interface.
+func (s *ShardedSet[T]) Size() int {
+	if s == nil {
+		return 0
+	}
+
+	return int(s.size.Load())
+}
+
+// IsEmpty implements the Set interface.
+func (s *ShardedSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Reset implements the Set interface.
+func (s *ShardedSet[T]) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.lockAll(true)
+	defer s.unlockAll(true)
+
+	for i := range s.shards {
+		s.shards[i].elems = nil
+	}
+
+	s.size.Store(0)
+}
+
+// Add implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewShardedSet.
+func (s *ShardedSet[T]) Add(elem T) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if s.hash == nil {
+		return common.NewErrNilParam("hash")
+	}
+
+	sh := s.shardOf(elem)
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if _, ok := sh.elems[elem]; ok {
+		return nil
+	}
+
+	if sh.elems == nil {
+		sh.elems = make(map[T]struct{})
+	}
+
+	sh.elems[elem] = struct{}{}
+	s.size.Add(1)
+
+	return nil
+}
+
+// AddMany implements the Set interface.
+//
+// The elements are not added atomically.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewShardedSet.
+func (s *ShardedSet[T]) AddMany(elems []T) error {
+	for _, elem := range elems {
+		err := s.Add(elem)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (s *ShardedSet[T]) Contains(elem T) bool {
+	if s == nil || s.hash == nil {
+		return false
+	}
+
+	sh := s.shardOf(elem)
+
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	_, ok := sh.elems[elem]
+	return ok
+}
+
+// snapshot returns the elements of every shard, copied while holding the read
+// lock of every shard.
+func (s *ShardedSet[T]) snapshot() []T {
+	s.lockAll(false)
+	defer s.unlockAll(false)
+
+	elems := make([]T, 0, s.size.Load())
+
+	for i := range s.shards {
+		for elem := range s.shards[i].elems {
+			elems = append(elems, elem)
+		}
+	}
+
+	return elems
+}
+
+// Elem implements the Set interface.
+//
+// The elements are copied while holding the read lock of every shard when the
+// iteration starts; thus, the iteration sees a consistent snapshot of the set,
+// and the set can be modified during the iteration.
+func (s *ShardedSet[T]) Elem() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil {
+			return
+		}
+
+		for _, elem := range s.snapshot() {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Remove implements the Set interface.
+func (s *ShardedSet[T]) Remove(elem T) bool {
+	if s == nil || s.hash == nil {
+		return false
+	}
+
+	sh := s.shardOf(elem)
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if _, ok := sh.elems[elem]; !ok {
+		return false
+	}
+
+	delete(sh.elems, elem)
+	s.size.Add(-1)
+
+	return true
+}
+
+// RemoveMany implements the Set interface.
+//
+// The elements are not removed atomically.
+func (s *ShardedSet[T]) RemoveMany(elems []T) int {
+	var count int
+
+	for _, elem := range elems {
+		if s.Remove(elem) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is an arbitrary one.
+func (s *ShardedSet[T]) Pop() (T, error) {
+	if s == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	for i := range s.shards {
+		sh := &s.shards[i]
+
+		sh.mu.Lock()
+
+		for elem := range sh.elems {
+			delete(sh.elems, elem)
+			s.size.Add(-1)
+
+			sh.mu.Unlock()
+
+			return elem, nil
+		}
+
+		sh.mu.Unlock()
+	}
+
+	return *new(T), ErrEmptySet
+}
+
+// RemoveIf implements the Set interface.
+//
+// The shards are processed one at a time while holding their write lock; thus,
+// the predicate must not use the set.
+func (s *ShardedSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	var count int
+
+	for i := range s.shards {
+		sh := &s.shards[i]
+
+		sh.mu.Lock()
+
+		var removed int
+
+		for elem := range sh.elems {
+			if pred(elem) {
+				delete(sh.elems, elem)
+				removed++
+			}
+		}
+
+		s.size.Add(int64(-removed))
+
+		sh.mu.Unlock()
+
+		count += removed
+	}
+
+	return count
+}
+
+// Clone implements the Set interface.
+//
+// The copy has the same number of shards and the same hash function.
+func (s *ShardedSet[T]) Clone() Set[T] {
+	if s == nil {
+		return nil
+	}
+
+	c := &ShardedSet[T]{
+		shards: make([]shard[T], len(s.shards)),
+		hash:   s.hash,
+	}
+
+	for _, elem := range s.snapshot() {
+		_ = c.Add(elem)
+	}
+
+	return c
+}
+
+// Clear implements the Set interface.
+func (s *ShardedSet[T]) Clear() {
+	if s == nil {
+		return
+	}
+
+	s.lockAll(true)
+	defer s.unlockAll(true)
+
+	for i := range s.shards {
+		clear(s.shards[i].elems)
+	}
+
+	s.size.Store(0)
+}
@@ -0,0 +1,156 @@
+package sets
+
+import (
+	"sync"
+	"testing"
+)
+
+// testConcurrent hammers the set from several goroutines and checks that every
+// snapshot taken by Elem is consistent. Run with -race.
+func testConcurrent(t *testing.T, s Set[int]) {
+	t.Helper()
+
+	const (
+		workers = 8
+		rounds  = 500
+	)
+
+	var wg sync.WaitGroup
+
+	for w := range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range rounds {
+				// The even element is always added before and removed after its
+				// odd partner: a snapshot never holds an odd element alone.
+				elem := 2 * (w*rounds + i)
+
+				_ = s.Add(elem)
+				_ = s.Add(elem + 1)
+
+				_ = s.Contains(elem)
+
+				if i%2 == 0 {
+					s.Remove(elem + 1)
+					s.Remove(elem)
+				}
+			}
+		}()
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for range rounds {
+			seen := make(map[int]struct{})
+
+			for elem := range s.Elem() {
+				seen[elem] = struct{}{}
+			}
+
+			for elem := range seen {
+				if _, ok := seen[elem-1]; elem%2 == 1 && !ok {
+					t.Errorf("want %d in the snapshot with %d", elem-1, elem)
+				}
+			}
+
+			_ = s.Size()
+		}
+	}()
+
+	wg.Wait()
+
+	if want := workers * rounds; s.Size() != want {
+		t.Errorf("want size %d, got %d", want, s.Size())
+	}
+}
+
+// TestSync_Concurrent tests Sync under concurrent use.
+func TestSync_Concurrent(t *testing.T) {
+	s, err := NewSync(New[int]())
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	testConcurrent(t, s)
+
+	err = s.Do(func(set Set[int]) error {
+		if !set.Contains(0) {
+			return set.Add(0)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Errorf("want no error, got %v", err)
+	}
+
+	t.Run("IntervalSet", func(t *testing.T) {
+		s, _ := NewSync[int](NewIntegerSet[int]())
+		testConcurrent(t, s)
+	})
+
+	t.Run("TreeSet", func(t *testing.T) {
+		s, _ := NewSync[int](NewTreeSet[int](nil))
+		testConcurrent(t, s)
+	})
+}
+
+// TestShardedSet_Concurrent tests ShardedSet under concurrent use.
+func TestShardedSet_Concurrent(t *testing.T) {
+	s, err := NewShardedSet(16, HashInteger[int])
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	testConcurrent(t, s)
+
+	if removed := s.RemoveIf(func(elem int) bool { return elem%2 == 0 }); removed != s.Size() {
+		t.Errorf("want as many even as odd elements, got %d and %d", removed, s.Size())
+	}
+}
+
+// TestSync_Zero tests a Sync that was not created with NewSync.
+func TestSync_Zero(t *testing.T) {
+	var s Sync[int]
+
+	if s.Size() != 0 || !s.IsEmpty() || s.Contains(1) {
+		t.Errorf("want an empty set")
+	}
+
+	if err := s.Add(1); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if err := s.AddMany([]int{1, 2}); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if err := s.Do(func(set Set[int]) error { return nil }); !isBadParam(err) {
+		t.Errorf("want a bad parameter error, got %v", err)
+	}
+
+	if _, err := s.Pop(); err != ErrEmptySet {
+		t.Errorf("want %v, got %v", ErrEmptySet, err)
+	}
+
+	for elem := range s.Elem() {
+		t.Errorf("want no element, got %d", elem)
+	}
+
+	s.Reset()
+	s.Clear()
+
+	if s.Remove(1) || s.RemoveMany([]int{1}) != 0 || s.RemoveIf(func(int) bool { return true }) != 0 {
+		t.Errorf("want nothing to be removed")
+	}
+
+	if clone := s.Clone(); clone == nil || !clone.IsEmpty() {
+		t.Errorf("want an empty clone, got %v", clone)
+	}
+}
@@ -0,0 +1,671 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+const (
+	// treeDegree is the minimum degree of the B-tree of a TreeSet: every node but
+	// the root holds between treeDegree-1 and 2*treeDegree-1 elements.
+	treeDegree int = 16
+
+	// treeMaxItems is the maximum number of elements of a node.
+	treeMaxItems int = 2*treeDegree - 1
+
+	// treeMinItems is the minimum number of elements of a node other than the root.
+	treeMinItems int = treeDegree - 1
+)
+
+// treeNode is a node of the B-tree of a TreeSet.
+type treeNode[T any] struct {
+	// items are the elements of the node, in ascending order.
+	items []T
+
+	// children are the subtrees of the node. Nil for leaves; otherwise, it has
+	// exactly one more subtree than there are items, and children[i] holds the
+	// elements between items[i-1] and items[i].
+	children []*treeNode[T]
+}
+
+// isLeaf checks whether the node is a leaf.
+func (n *treeNode[T]) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+// clone returns a deep copy of the subtree rooted at the node.
+func (n *treeNode[T]) clone() *treeNode[T] {
+	c := &treeNode[T]{
+		items: slices.Clone(n.items),
+	}
+
+	if !n.isLeaf() {
+		c.children = make([]*treeNode[T], len(n.children))
+
+		for i, child := range n.children {
+			c.children[i] = child.clone()
+		}
+	}
+
+	return c
+}
+
+// walk yields the elements of the subtree rooted at the node that are greater
+// than or equal to lo, in ascending order, and stops at the first element that
+// is not less than hi. A nil bound is not checked.
+//
+// Returns:
+//   - bool: False if the iteration must stop.
+func (n *treeNode[T]) walk(compare func(a, b T) int, lo, hi *T, yield func(T) bool) bool {
+	var start int
+
+	if lo != nil {
+		start, _ = slices.BinarySearchFunc(n.items, *lo, compare)
+	}
+
+	for i := start; i < len(n.items); i++ {
+		if !n.isLeaf() && !n.children[i].walk(compare, lo, hi, yield) {
+			return false
+		}
+
+		if hi != nil && compare(n.items[i], *hi) >= 0 {
+			return false
+		}
+
+		if !yield(n.items[i]) {
+			return false
+		}
+	}
+
+	if n.isLeaf() {
+		return true
+	}
+
+	return n.children[len(n.items)].walk(compare, lo, hi, yield)
+}
+
+// TreeSet is an ordered set backed by a B-tree. Unlike OrderedSet, adding,
+// removing and looking up an element take O(log n) time, which makes it suited
+// to large sets. A TreeSet must be created with NewTreeSet or NewTreeFuncSet.
+type TreeSet[T any] struct {
+	// root is the root of the B-tree. Nil if the set is empty.
+	root *treeNode[T]
+
+	// size is the number of elements of the set.
+	size int
+
+	// compare is the comparison function of the set.
+	compare func(a, b T) int
+}
+
+// NewTreeSet creates a new tree set of ordered elements from the provided
+// elements.
+//
+// Parameters:
+//   - elems: The elements to initialize the set with. The slice is not retained.
+//
+// Returns:
+//   - *TreeSet[T]: The new set. Never returns nil.
+func NewTreeSet[T cmp.Ordered](elems []T) *TreeSet[T] {
+	s, _ := NewTreeFuncSet(cmp.Compare[T], elems)
+	return s
+}
+
+// NewTreeFuncSet creates a new tree set ordered by the given comparison function
+// from the provided elements. When several elements compare equal, only the
+// first one is kept.
+//
+// Parameters:
+//   - compare: The comparison function. It must return a negative number when
+//     a < b, a positive number when a > b and 0 when a == b.
+//   - elems: The elements to initialize the set with. The slice is not retained.
+//
+// Returns:
+//   - *TreeSet[T]: The new set. Nil if an error occurred.
+//   - error: An error if the set could not be created.
+//
+// Errors:
+//   - common.ErrBadParam: If compare is nil.
+func NewTreeFuncSet[T any](compare func(a, b T) int, elems []T) (*TreeSet[T], error) {
+	if compare == nil {
+		return nil, common.NewErrNilParam("compare")
+	}
+
+	s := &TreeSet[T]{
+		compare: compare,
+	}
+
+	for _, elem := range sortFunc(slices.Clone(elems), compare) {
+		s.insert(elem)
+	}
+
+	return s, nil
+}
+
+// Size implements the Set interface.
+func (s TreeSet[T]) Size() int {
+	return s.size
+}
+
+// IsEmpty implements the Set interface.
+func (s TreeSet[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Reset implements the Set interface.
+//
+// The comparison function is kept.
+func (s *TreeSet[T]) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.root = nil
+	s.size = 0
+}
+
+// splitChild splits the full child at index i of the given node in two,
+// moving its median element up into the node.
+func (s *TreeSet[T]) splitChild(parent *treeNode[T], i int) {
+	child := parent.children[i]
+	median := child.items[treeMinItems]
+
+	right := &treeNode[T]{
+		items: slices.Clone(child.items[treeMinItems+1:]),
+	}
+
+	clear(child.items[treeMinItems:])
+	child.items = child.items[:treeMinItems]
+
+	if !child.isLeaf() {
+		right.children = slices.Clone(child.children[treeMinItems+1:])
+
+		clear(child.children[treeMinItems+1:])
+		child.children = child.children[:treeMinItems+1]
+	}
+
+	parent.items = slices.Insert(parent.items, i, median)
+	parent.children = slices.Insert(parent.children, i+1, right)
+}
+
+// insert adds the element to the tree. The comparison function must not be nil.
+//
+// Returns:
+//   - bool: True if the element was added, false if it was already present.
+func (s *TreeSet[T]) insert(elem T) bool {
+	if s.root == nil {
+		s.root = &treeNode[T]{
+			items: []T{elem},
+		}
+		s.size = 1
+
+		return true
+	}
+
+	if len(s.root.items) == treeMaxItems {
+		s.root = &treeNode[T]{
+			children: []*treeNode[T]{s.root},
+		}
+
+		s.splitChild(s.root, 0)
+	}
+
+	n := s.root
+
+	for {
+		pos, ok := slices.BinarySearchFunc(n.items, elem, s.compare)
+		if ok {
+			return false
+		}
+
+		if n.isLeaf() {
+			n.items = slices.Insert(n.items, pos, elem)
+			s.size++
+
+			return true
+		}
+
+		if len(n.children[pos].items) == treeMaxItems {
+			s.splitChild(n, pos)
+
+			c := s.compare(elem, n.items[pos])
+			if c == 0 {
+				return false
+			} else if c > 0 {
+				pos++
+			}
+		}
+
+		n = n.children[pos]
+	}
+}
+
+// mergeChildren merges the child at index i+1 of the given node and the
+// element that separates them into the child at index i.
+func mergeChildren[T any](n *treeNode[T], i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+
+	n.items = slices.Delete(n.items, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// fillChild makes sure that the child at index i of the given node has more
+// than the minimum number of elements, by borrowing an element from a sibling
+// or by merging it with a sibling.
+//
+// Returns:
+//   - int: The index of the child that now holds the elements of child i.
+func fillChild[T any](n *treeNode[T], i int) int {
+	child := n.children[i]
+
+	if len(child.items) > treeMinItems {
+		return i
+	}
+
+	if i > 0 && len(n.children[i-1].items) > treeMinItems {
+		left := n.children[i-1]
+		last := len(left.items) - 1
+
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[last]
+
+		left.items[last] = *new(T)
+		left.items = left.items[:last]
+
+		if !left.isLeaf() {
+			child.children = slices.Insert(child.children, 0, left.children[last+1])
+
+			left.children[last+1] = nil
+			left.children = left.children[:last+1]
+		}
+
+		return i
+	}
+
+	if i < len(n.items) && len(n.children[i+1].items) > treeMinItems {
+		right := n.children[i+1]
+
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+
+		if !right.isLeaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+
+		return i
+	}
+
+	if i == len(n.items) {
+		i--
+	}
+
+	mergeChildren(n, i)
+
+	return i
+}
+
+// remove removes the element from the tree. The comparison function must not
+// be nil.
+//
+// Returns:
+//   - bool: True if the element was removed, false if it was not present.
+func (s *TreeSet[T]) remove(elem T) bool {
+	if s.root == nil {
+		return false
+	}
+
+	removed := s.removeFrom(s.root, elem)
+
+	if len(s.root.items) == 0 {
+		if s.root.isLeaf() {
+			s.root = nil
+		} else {
+			s.root = s.root.children[0]
+		}
+	}
+
+	if removed {
+		s.size--
+	}
+
+	return removed
+}
+
+// removeFrom removes the element from the subtree rooted at the given node,
+// which must have more than the minimum number of elements unless it is the
+// root.
+func (s *TreeSet[T]) removeFrom(n *treeNode[T], elem T) bool {
+	for {
+		pos, ok := slices.BinarySearchFunc(n.items, elem, s.compare)
+
+		if n.isLeaf() {
+			if ok {
+				n.items = slices.Delete(n.items, pos, pos+1)
+			}
+
+			return ok
+		}
+
+		if !ok {
+			n = n.children[fillChild(n, pos)]
+			continue
+		}
+
+		switch left, right := n.children[pos], n.children[pos+1]; {
+		case len(left.items) > treeMinItems:
+			pred := left
+			for !pred.isLeaf() {
+				pred = pred.children[len(pred.children)-1]
+			}
+
+			elem = pred.items[len(pred.items)-1]
+			n.items[pos] = elem
+			n = left
+		case len(right.items) > treeMinItems:
+			succ := right
+			for !succ.isLeaf() {
+				succ = succ.children[0]
+			}
+
+			elem = succ.items[0]
+			n.items[pos] = elem
+			n = right
+		default:
+			mergeChildren(n, pos)
+			n = left
+		}
+	}
+}
+
+// Add implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewTreeSet or
+//     NewTreeFuncSet.
+func (s *TreeSet[T]) Add(elem T) error {
+	if s == nil {
+		return common.ErrNilReceiver
+	} else if s.compare == nil {
+		return common.NewErrNilParam("compare")
+	}
+
+	s.insert(elem)
+
+	return nil
+}
+
+// AddMany implements the Set interface.
+//
+// Errors:
+//   - common.ErrNilReceiver: If the receiver is nil.
+//   - common.ErrBadParam: If the set was not created with NewTreeSet or
+//     NewTreeFuncSet.
+func (s *TreeSet[T]) AddMany(elems []T) error {
+	if len(elems) == 0 {
+		return nil
+	} else if s == nil {
+		return common.ErrNilReceiver
+	} else if s.compare == nil {
+		return common.NewErrNilParam("compare")
+	}
+
+	for _, elem := range elems {
+		s.insert(elem)
+	}
+
+	return nil
+}
+
+// Contains implements the Set interface.
+func (s TreeSet[T]) Contains(elem T) bool {
+	n := s.root
+
+	for n != nil {
+		pos, ok := slices.BinarySearchFunc(n.items, elem, s.compare)
+		if ok {
+			return true
+		} else if n.isLeaf() {
+			return false
+		}
+
+		n = n.children[pos]
+	}
+
+	return false
+}
+
+// Elem implements the Set interface.
+//
+// The elements are iterated in ascending order.
+func (s TreeSet[T]) Elem() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s.root != nil {
+			s.root.walk(s.compare, nil, nil, yield)
+		}
+	}
+}
+
+// Remove implements the Set interface.
+func (s *TreeSet[T]) Remove(elem T) bool {
+	if s == nil {
+		return false
+	}
+
+	return s.remove(elem)
+}
+
+// RemoveMany implements the Set interface.
+func (s *TreeSet[T]) RemoveMany(elems []T) int {
+	if s == nil {
+		return 0
+	}
+
+	var count int
+
+	for _, elem := range elems {
+		if s.remove(elem) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Pop implements the Set interface.
+//
+// The removed element is the greatest one.
+func (s *TreeSet[T]) Pop() (T, error) {
+	if s == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	elem, err := s.Max()
+	if err != nil {
+		return *new(T), err
+	}
+
+	s.remove(elem)
+
+	return elem, nil
+}
+
+// RemoveIf implements the Set interface.
+func (s *TreeSet[T]) RemoveIf(pred func(elem T) bool) int {
+	if s == nil || pred == nil {
+		return 0
+	}
+
+	var removed []T
+
+	for elem := range s.Elem() {
+		if pred(elem) {
+			removed = append(removed, elem)
+		}
+	}
+
+	for _, elem := range removed {
+		s.remove(elem)
+	}
+
+	return len(removed)
+}
+
+// Clone implements the Set interface.
+func (s TreeSet[T]) Clone() Set[T] {
+	c := &TreeSet[T]{
+		size:    s.size,
+		compare: s.compare,
+	}
+
+	if s.root != nil {
+		c.root = s.root.clone()
+	}
+
+	return c
+}
+
+// Clear implements the Set interface.
+//
+// The nodes of a tree set cannot be reused; thus, Clear is the same as Reset.
+func (s *TreeSet[T]) Clear() {
+	s.Reset()
+}
+
+// Min returns the smallest element of the set.
+//
+// Returns:
+//   - T: The smallest element.
+//   - error: An error if the set is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the set is empty.
+func (s TreeSet[T]) Min() (T, error) {
+	if s.root == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	n := s.root
+	for !n.isLeaf() {
+		n = n.children[0]
+	}
+
+	return n.items[0], nil
+}
+
+// Max returns the greatest element of the set.
+//
+// Returns:
+//   - T: The greatest element.
+//   - error: An error if the set is empty.
+//
+// Errors:
+//   - ErrEmptySet: If the set is empty.
+func (s TreeSet[T]) Max() (T, error) {
+	if s.root == nil {
+		return *new(T), ErrEmptySet
+	}
+
+	n := s.root
+	for !n.isLeaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	return n.items[len(n.items)-1], nil
+}
+
+// Floor returns the greatest element of the set that is less than or equal to
+// the given element.
+//
+// Parameters:
+//   - elem: The element to compare with.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func (s TreeSet[T]) Floor(elem T) (T, bool) {
+	var (
+		best  T
+		found bool
+	)
+
+	for n := s.root; n != nil; {
+		pos, ok := slices.BinarySearchFunc(n.items, elem, s.compare)
+		if ok {
+			return n.items[pos], true
+		}
+
+		if pos > 0 {
+			best, found = n.items[pos-1], true
+		}
+
+		if n.isLeaf() {
+			break
+		}
+
+		n = n.children[pos]
+	}
+
+	return best, found
+}
+
+// Ceiling returns the smallest element of the set that is greater than or equal
+// to the given element.
+//
+// Parameters:
+//   - elem: The element to compare with.
+//
+// Returns:
+//   - T: The found element.
+//   - bool: False if there is no such element.
+func (s TreeSet[T]) Ceiling(elem T) (T, bool) {
+	var (
+		best  T
+		found bool
+	)
+
+	for n := s.root; n != nil; {
+		pos, ok := slices.BinarySearchFunc(n.items, elem, s.compare)
+		if ok {
+			return n.items[pos], true
+		}
+
+		if pos < len(n.items) {
+			best, found = n.items[pos], true
+		}
+
+		if n.isLeaf() {
+			break
+		}
+
+		n = n.children[pos]
+	}
+
+	return best, found
+}
+
+// Range iterates, in ascending order, through the elements of the set that are
+// greater than or equal to lo and less than hi.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - iter.Seq[T]: The elements in range. Never returns nil.
+func (s TreeSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s.root != nil && s.compare(lo, hi) < 0 {
+			s.root.walk(s.compare, &lo, &hi, yield)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package sets
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// checkTree fails the test if the B-tree of the set does not respect its
+// invariants.
+func checkTree(t *testing.T, s *TreeSet[int]) {
+	t.Helper()
+
+	var count int
+
+	leaf_depth := -1
+
+	var check func(n *treeNode[int], depth int, is_root bool)
+
+	check = func(n *treeNode[int], depth int, is_root bool) {
+		if !is_root && len(n.items) < treeMinItems {
+			t.Fatalf("want at least %d items, got %d", treeMinItems, len(n.items))
+		} else if len(n.items) > treeMaxItems {
+			t.Fatalf("want at most %d items, got %d", treeMaxItems, len(n.items))
+		}
+
+		count += len(n.items)
+
+		if n.isLeaf() {
+			if leaf_depth == -1 {
+				leaf_depth = depth
+			} else if leaf_depth != depth {
+				t.Fatalf("want every leaf at depth %d, got %d", leaf_depth, depth)
+			}
+
+			return
+		}
+
+		if len(n.children) != len(n.items)+1 {
+			t.Fatalf("want %d children, got %d", len(n.items)+1, len(n.children))
+		}
+
+		for _, child := range n.children {
+			check(child, depth+1, false)
+		}
+	}
+
+	if s.root != nil {
+		check(s.root, 0, true)
+	}
+
+	if count != s.size {
+		t.Fatalf("want size %d, got %d", count, s.size)
+	}
+
+	elems := slices.Collect(s.Elem())
+	if !slices.IsSorted(elems) || len(slices.Compact(slices.Clone(elems))) != len(elems) {
+		t.Fatalf("want sorted unique elements")
+	}
+}
+
+// TestTreeSet tests random insertions and removals against a map.
+func TestTreeSet(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	s := NewTreeSet[int](nil)
+	want := make(map[int]struct{})
+
+	for i := 0; i < 20000; i++ {
+		elem := rng.IntN(2000)
+
+		if rng.IntN(3) == 0 {
+			_, ok := want[elem]
+			delete(want, elem)
+
+			if got := s.Remove(elem); got != ok {
+				t.Fatalf("want Remove(%d) = %t, got %t", elem, ok, got)
+			}
+		} else {
+			want[elem] = struct{}{}
+			_ = s.Add(elem)
+		}
+
+		if i%1000 == 0 {
+			checkTree(t, s)
+		}
+	}
+
+	checkTree(t, s)
+
+	if s.Size() != len(want) {
+		t.Fatalf("want size %d, got %d", len(want), s.Size())
+	}
+
+	for elem := range want {
+		if !s.Contains(elem) {
+			t.Fatalf("want %d to be present", elem)
+		}
+	}
+
+	for s.Size() > 0 {
+		_, _ = s.Pop()
+	}
+
+	checkTree(t, s)
+}
+
+// TestTreeSet_Navigation tests the navigation queries of TreeSet.
+func TestTreeSet_Navigation(t *testing.T) {
+	elems := make([]int, 0, 500)
+	for i := range 500 {
+		elems = append(elems, i*2)
+	}
+
+	s := NewTreeSet(elems)
+
+	if v, ok := s.Floor(101); !ok || v != 100 {
+		t.Errorf("want floor 100, got %d (%t)", v, ok)
+	}
+
+	if v, ok := s.Ceiling(101); !ok || v != 102 {
+		t.Errorf("want ceiling 102, got %d (%t)", v, ok)
+	}
+
+	if _, ok := s.Ceiling(999); ok {
+		t.Errorf("want no ceiling of 999")
+	}
+
+	got := slices.Collect(s.Range(95, 105))
+	if want := []int{96, 98, 100, 102, 104}; !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	if v, _ := s.Max(); v != 998 {
+		t.Errorf("want max 998, got %d", v)
+	}
+}
+
+// benchSizes are the set sizes of the benchmarks.
+var benchSizes = []int{1_000, 10_000, 100_000}
+
+// benchElems returns n distinct elements in random order.
+func benchElems(n int) []int {
+	return rand.New(rand.NewPCG(3, 4)).Perm(n)
+}
+
+// BenchmarkTreeSet_Add benchmarks building a tree set one element at a time.
+func BenchmarkTreeSet_Add(b *testing.B) {
+	for _, n := range benchSizes {
+		elems := benchElems(n)
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for range b.N {
+				s := NewTreeSet[int](nil)
+
+				for _, elem := range elems {
+					_ = s.Add(elem)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkOrderedSet_Add benchmarks building an ordered set one element at a time.
+func BenchmarkOrderedSet_Add(b *testing.B) {
+	for _, n := range benchSizes {
+		elems := benchElems(n)
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for range b.N {
+				s := NewOrderedSet[int](nil)
+
+				for _, elem := range elems {
+					_ = s.Add(elem)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTreeSet_Remove benchmarks emptying a tree set one element at a time.
+func BenchmarkTreeSet_Remove(b *testing.B) {
+	for _, n := range benchSizes {
+		elems := benchElems(n)
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for range b.N {
+				b.StopTimer()
+				s := NewTreeSet(elems)
+				b.StartTimer()
+
+				for _, elem := range elems {
+					s.Remove(elem)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkOrderedSet_Remove benchmarks emptying an ordered set one element at
+// a time.
+func BenchmarkOrderedSet_Remove(b *testing.B) {
+	for _, n := range benchSizes {
+		elems := benchElems(n)
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for range b.N {
+				b.StopTimer()
+				s := &OrderedSet[int]{elems: slices.Sorted(slices.Values(elems))}
+				b.StartTimer()
+
+				for _, elem := range elems {
+					s.Remove(elem)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTreeSet_Contains benchmarks lookups in a tree set.
+func BenchmarkTreeSet_Contains(b *testing.B) {
+	for _, n := range benchSizes {
+		elems := benchElems(n)
+		s := NewTreeSet(elems)
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := range b.N {
+				_ = s.Contains(elems[i%n])
+			}
+		})
+	}
+}
+
+// BenchmarkOrderedSet_Contains benchmarks lookups in an ordered set.
+func BenchmarkOrderedSet_Contains(b *testing.B) {
+	for _, n := range benchSizes {
+		elems := benchElems(n)
+		s := &OrderedSet[int]{elems: slices.Sorted(slices.Values(elems))}
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := range b.N {
+				_ = s.Contains(elems[i%n])
+			}
+		})
+	}
+}
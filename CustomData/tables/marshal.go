@@ -0,0 +1,120 @@
+package tables
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PlayerR9/mysd-lib/common"
+)
+
+// tableData is the serialized form of a Table.
+type tableData[T any] struct {
+	// Width is the width of the table.
+	Width int `json:"width"`
+
+	// Height is the height of the table.
+	Height int `json:"height"`
+
+	// Cells are the rows of the table.
+	Cells [][]T `json:"cells"`
+}
+
+// toData returns the serialized form of the table.
+func (t Table[T]) toData() tableData[T] {
+	cells := make([][]T, 0, t.height)
+
+	for i := 0; i < t.height; i++ {
+		row := make([]T, t.width)
+		copy(row, t.table[i])
+
+		cells = append(cells, row)
+	}
+
+	return tableData[T]{
+		Width:  t.width,
+		Height: t.height,
+		Cells:  cells,
+	}
+}
+
+// fromData replaces the state of the table with the given serialized form.
+//
+// Returns:
+//   - error: An error if the serialized form is not a valid table.
+func (t *Table[T]) fromData(d tableData[T]) error {
+	if d.Width < 0 {
+		return common.NewErrBadParam("width", "must be non-negative")
+	} else if d.Height < 0 {
+		return common.NewErrBadParam("height", "must be non-negative")
+	} else if len(d.Cells) != d.Height {
+		return fmt.Errorf("expected %d rows, got %d", d.Height, len(d.Cells))
+	}
+
+	for i, row := range d.Cells {
+		if len(row) != d.Width {
+			return common.NewErrAt(i, fmt.Errorf("expected %d cells, got %d", d.Width, len(row)))
+		}
+	}
+
+	t.Cleanup()
+
+	t.table = d.Cells
+	t.width = d.Width
+	t.height = d.Height
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The table is encoded as an object holding its width, its height and its
+// rows of cells.
+func (t Table[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.toData())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Table[T]) UnmarshalJSON(data []byte) error {
+	if t == nil {
+		return common.ErrNilReceiver
+	}
+
+	var d tableData[T]
+
+	err := json.Unmarshal(data, &d)
+	if err != nil {
+		return err
+	}
+
+	return t.fromData(d)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (t Table[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(t.toData())
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *Table[T]) UnmarshalBinary(data []byte) error {
+	if t == nil {
+		return common.ErrNilReceiver
+	}
+
+	var d tableData[T]
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&d)
+	if err != nil {
+		return err
+	}
+
+	return t.fromData(d)
+}
@@ -0,0 +1,44 @@
+package tables
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestTable_Marshal tests JSON and binary round-trips of tables.
+func TestTable_Marshal(t *testing.T) {
+	table, _ := NewTable[int](2, 3)
+	table.SetCellAt(5, 1, 2)
+
+	data, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	var fromJSON Table[int]
+
+	err = json.Unmarshal(data, &fromJSON)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	data, _ = table.MarshalBinary()
+
+	var fromBinary Table[int]
+
+	err = fromBinary.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	for _, got := range []Table[int]{fromJSON, fromBinary} {
+		if got.Width() != 2 || got.Height() != 3 || got.CellAt(1, 2) != 5 {
+			t.Errorf("want 2x3 table with 5 at (1, 2), got %v", got.table)
+		}
+	}
+
+	err = json.Unmarshal([]byte(`{"width":2,"height":1,"cells":[[1]]}`), &fromJSON)
+	if err == nil {
+		t.Errorf("want error, got nil")
+	}
+}
@@ -2,6 +2,7 @@ package tables
 
 import (
 	"iter"
+	"slices"
 
 	"github.com/PlayerR9/mysd-lib/common"
 )
@@ -119,6 +120,8 @@ func (t *Table[T]) ResizeWidth(new_width int) error {
 		}
 	}
 
+	t.width = new_width
+
 	return nil
 }
 
@@ -153,6 +156,8 @@ func (t *Table[T]) ResizeHeight(new_height int) error {
 		}
 	}
 
+	t.height = new_height
+
 	return nil
 }
 
@@ -185,6 +190,151 @@ func (t Table[T]) Row() iter.Seq2[int, []T] {
 	}
 }
 
+// Column returns an iterator over the cells of a column of the table, from top
+// to bottom. Nothing is yielded if x is out of bounds.
+//
+// Parameters:
+//   - x: The x position of the column.
+//
+// Returns:
+//   - iter.Seq2[int, T]: An iterator over the y positions and the cells of the
+//     column. Never returns nil.
+func (t Table[T]) Column(x int) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if x < 0 || x >= t.width {
+			return
+		}
+
+		for i := 0; i < t.height; i++ {
+			if !yield(i, t.table[i][x]) {
+				return
+			}
+		}
+	}
+}
+
+// Cell returns an iterator over the cells of the table, row by row from top to
+// bottom and from left to right within a row.
+//
+// Returns:
+//   - iter.Seq2[common.Pair[int, int], T]: An iterator over the positions, as
+//     (x, y) pairs, and the cells of the table. Never returns nil.
+func (t Table[T]) Cell() iter.Seq2[common.Pair[int, int], T] {
+	return func(yield func(common.Pair[int, int], T) bool) {
+		for i := 0; i < t.height; i++ {
+			for j := 0; j < t.width; j++ {
+				if !yield(common.NewPair(j, i), t.table[i][j]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// InsertRow inserts a row of zero values before the row at the specified
+// position; if y is the height of the table, the row is added at the bottom.
+// Nothing is done if the receiver is nil or y is out of bounds.
+//
+// Parameters:
+//   - y: The y position of the new row.
+func (t *Table[T]) InsertRow(y int) {
+	if t == nil || y < 0 || y > t.height {
+		return
+	}
+
+	t.table = slices.Insert(t.table, y, make([]T, t.width))
+	t.height++
+}
+
+// DeleteRow deletes the row at the specified position; the rows below it move
+// up. Nothing is done if the receiver is nil or y is out of bounds.
+//
+// Parameters:
+//   - y: The y position of the row.
+func (t *Table[T]) DeleteRow(y int) {
+	if t == nil || y < 0 || y >= t.height {
+		return
+	}
+
+	clear(t.table[y])
+
+	t.table = slices.Delete(t.table, y, y+1)
+	t.height--
+}
+
+// InsertColumn inserts a column of zero values before the column at the
+// specified position; if x is the width of the table, the column is added on
+// the right. Nothing is done if the receiver is nil or x is out of bounds.
+//
+// Parameters:
+//   - x: The x position of the new column.
+func (t *Table[T]) InsertColumn(x int) {
+	if t == nil || x < 0 || x > t.width {
+		return
+	}
+
+	for i := 0; i < t.height; i++ {
+		t.table[i] = slices.Insert(t.table[i], x, *new(T))
+	}
+
+	t.width++
+}
+
+// DeleteColumn deletes the column at the specified position; the columns on
+// its right move left. Nothing is done if the receiver is nil or x is out of
+// bounds.
+//
+// Parameters:
+//   - x: The x position of the column.
+func (t *Table[T]) DeleteColumn(x int) {
+	if t == nil || x < 0 || x >= t.width {
+		return
+	}
+
+	for i := 0; i < t.height; i++ {
+		t.table[i] = slices.Delete(t.table[i], x, x+1)
+	}
+
+	t.width--
+}
+
+// SwapRows swaps the rows at the specified positions. Nothing is done if the
+// receiver is nil or either position is out of bounds.
+//
+// Parameters:
+//   - y1: The y position of the first row.
+//   - y2: The y position of the second row.
+func (t *Table[T]) SwapRows(y1, y2 int) {
+	if t == nil || y1 < 0 || y1 >= t.height || y2 < 0 || y2 >= t.height {
+		return
+	}
+
+	t.table[y1], t.table[y2] = t.table[y2], t.table[y1]
+}
+
+// Transpose transposes the table: the cell at (x, y) moves to (y, x), and the
+// width and height are swapped. Does nothing if the receiver is nil.
+func (t *Table[T]) Transpose() {
+	if t == nil {
+		return
+	}
+
+	table := make([][]T, 0, t.width)
+
+	for j := 0; j < t.width; j++ {
+		row := make([]T, t.height)
+
+		for i := 0; i < t.height; i++ {
+			row[i] = t.table[i][j]
+		}
+
+		table = append(table, row)
+	}
+
+	t.table = table
+	t.width, t.height = t.height, t.width
+}
+
 // Cleanup cleans up the table. Does nothing if the receiver is nil or if
 // is already cleaned up.
 func (t *Table[T]) Cleanup() {
@@ -0,0 +1,102 @@
+package tables
+
+import (
+	"slices"
+	"testing"
+)
+
+// rowsOf returns a copy of the rows of the table.
+func rowsOf[T any](t *Table[T]) [][]T {
+	var rows [][]T
+
+	for _, row := range t.Row() {
+		rows = append(rows, slices.Clone(row))
+	}
+
+	return rows
+}
+
+// equalRows checks whether the table has the given rows.
+func equalRows(t *Table[int], want [][]int) bool {
+	got := rowsOf(t)
+
+	return t.Height() == len(want) && slices.EqualFunc(got, want, slices.Equal[[]int])
+}
+
+// newFilled creates a width x height table where the cell at (x, y) is 10*y + x.
+func newFilled(width, height int) *Table[int] {
+	table, _ := NewTable[int](width, height)
+
+	for y := range height {
+		for x := range width {
+			table.SetCellAt(10*y+x, x, y)
+		}
+	}
+
+	return table
+}
+
+// TestTable_Resize tests that resizing updates the dimensions of the table.
+func TestTable_Resize(t *testing.T) {
+	table := newFilled(2, 2)
+
+	_ = table.ResizeWidth(3)
+	_ = table.ResizeHeight(1)
+
+	if table.Width() != 3 || table.Height() != 1 {
+		t.Fatalf("want 3x1, got %dx%d", table.Width(), table.Height())
+	}
+
+	table.SetCellAt(7, 2, 0)
+
+	if got := table.CellAt(2, 0); got != 7 {
+		t.Errorf("want 7, got %d", got)
+	}
+
+	if !equalRows(table, [][]int{{0, 1, 7}}) {
+		t.Errorf("want [[0 1 7]], got %v", rowsOf(table))
+	}
+}
+
+// TestTable_Edit tests row and column editing.
+func TestTable_Edit(t *testing.T) {
+	table := newFilled(3, 2)
+
+	table.InsertRow(1)
+	table.InsertColumn(3)
+	table.InsertRow(5)
+	table.DeleteColumn(-1)
+
+	if !equalRows(table, [][]int{{0, 1, 2, 0}, {0, 0, 0, 0}, {10, 11, 12, 0}}) {
+		t.Errorf("want an inserted row and column, got %v", rowsOf(table))
+	}
+
+	table.DeleteRow(1)
+	table.DeleteColumn(0)
+	table.SwapRows(0, 1)
+
+	if !equalRows(table, [][]int{{11, 12, 0}, {1, 2, 0}}) || table.Width() != 3 {
+		t.Errorf("want [[11 12 0] [1 2 0]], got %v", rowsOf(table))
+	}
+
+	table.Transpose()
+
+	if !equalRows(table, [][]int{{11, 1}, {12, 2}, {0, 0}}) || table.Width() != 2 {
+		t.Errorf("want the transposed table, got %v", rowsOf(table))
+	}
+
+	var column []int
+	for _, cell := range table.Column(1) {
+		column = append(column, cell)
+	}
+
+	if want := []int{1, 2, 0}; !slices.Equal(column, want) {
+		t.Errorf("want %v, got %v", want, column)
+	}
+
+	for pos, cell := range table.Cell() {
+		if got := table.CellAt(pos.First, pos.Second); got != cell {
+			t.Errorf("want %d at %v, got %d", cell, pos, got)
+		}
+	}
+}